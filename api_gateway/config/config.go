@@ -17,8 +17,10 @@ type Config struct {
 	Jaeger     Jaeger
 	RabbitMQ   RabbitMQ
 	GRPC       GRPC
+	JWT        JWT
 }
 
+// HttpServer config, CookieSecure is disabled only for local development over http
 type HttpServer struct {
 	AppVersion        string
 	Port              string
@@ -28,7 +30,15 @@ type HttpServer struct {
 	WriteTimeout      time.Duration
 	CookieLifeTime    int
 	SessionCookieName string
+	CookieSecure      bool
 	CSRFHeader        string
+	CSRFAllowlist     []string
+}
+
+// JWT access tokens issued by user service
+type JWT struct {
+	Secret string
+	Issuer string
 }
 
 type GRPC struct {
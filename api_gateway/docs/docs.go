@@ -140,7 +140,7 @@ var doc = `{
         },
         "/hotels": {
             "get": {
-                "description": "Get hotels list with pagination using page and size query parameters",
+                "description": "Get hotels list with pagination using page and size or cursor query parameters, sorting and filters, next cursor is returned and accepted only for created_at sort",
                 "consumes": [
                     "application/json"
                 ],
@@ -163,6 +163,66 @@ var doc = `{
                         "description": "number of elements",
                         "name": "size",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next page cursor, only with created_at sort",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "rating, name, created_at, comments_count or distance",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "country",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "city",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "min rating",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "max rating",
+                        "name": "max_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only hotels with image",
+                        "name": "has_image",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "latitude for distance sort",
+                        "name": "latitude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "longitude for distance sort",
+                        "name": "longitude",
+                        "in": "query"
                     }
                 ],
                 "responses": {
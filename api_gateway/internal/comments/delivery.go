@@ -7,5 +7,14 @@ type Delivery interface {
 	CreateComment() echo.HandlerFunc
 	GetCommByID() echo.HandlerFunc
 	UpdateComment() echo.HandlerFunc
+	DeleteComment() echo.HandlerFunc
+	CreateReply() echo.HandlerFunc
+	UpdateReply() echo.HandlerFunc
+	UploadPhoto() echo.HandlerFunc
+	VoteHelpful() echo.HandlerFunc
+	ReportComment() echo.HandlerFunc
+	GetHiddenComments() echo.HandlerFunc
+	ReviewComment() echo.HandlerFunc
 	GetByHotelID() echo.HandlerFunc
+	GetMyComments() echo.HandlerFunc
 }
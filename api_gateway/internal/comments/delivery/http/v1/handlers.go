@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"bytes"
+	"io"
 	"net/http"
-	"strconv"
+	"sync"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -15,6 +17,11 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/models"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/utils"
+)
+
+const (
+	maxFileSize = 1024 * 1024 * 10
 )
 
 // CommentsHandlers
@@ -79,7 +86,7 @@ func (h *commentsHandlers) CreateComment() echo.HandlerFunc {
 // @Description Get comment by uuid
 // @Accept json
 // @Produce json
-// @Param comment_id query string false "comment uuid"
+// @Param comment_id path string true "comment uuid"
 // @Success 200 {object} models.Comment
 // @Router /comments/{comment_id} [get]
 func (h *commentsHandlers) GetCommByID() echo.HandlerFunc {
@@ -87,7 +94,7 @@ func (h *commentsHandlers) GetCommByID() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.GetCommByID")
 		defer span.Finish()
 
-		commUUID, err := uuid.FromString(c.QueryParam("comment_id"))
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
 		if err != nil {
 			h.logger.Error("uuid.FromString")
 			return httpErrors.ErrorCtxResponse(c, err)
@@ -109,7 +116,7 @@ func (h *commentsHandlers) GetCommByID() echo.HandlerFunc {
 // @Description Update comment by uuid
 // @Accept json
 // @Produce json
-// @Param comment_id query string false "comment uuid"
+// @Param comment_id path string true "comment uuid"
 // @Success 200 {object} models.Comment
 // @Router /comments/{comment_id} [put]
 func (h *commentsHandlers) UpdateComment() echo.HandlerFunc {
@@ -117,7 +124,7 @@ func (h *commentsHandlers) UpdateComment() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.UpdateComment")
 		defer span.Finish()
 
-		commUUID, err := uuid.FromString(c.QueryParam("comment_id"))
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
 		if err != nil {
 			h.logger.Error("uuid.FromString")
 			return httpErrors.ErrorCtxResponse(c, err)
@@ -145,13 +152,338 @@ func (h *commentsHandlers) UpdateComment() echo.HandlerFunc {
 	}
 }
 
+// Register DeleteComment
+// @Tags Comments
+// @Summary Delete comment by id
+// @Description Delete comment by uuid, allowed for author or admin
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "comment uuid"
+// @Success 200 {string} string "ok"
+// @Router /comments/{comment_id} [delete]
+func (h *commentsHandlers) DeleteComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.DeleteComment")
+		defer span.Finish()
+
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.commUC.DeleteComment(ctx, commUUID); err != nil {
+			h.logger.Error("commUC.DeleteComment")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// Register CreateReply
+// @Tags Comments
+// @Summary Reply to comment
+// @Description Reply to hotel comment, allowed for hotel owner or admin
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "comment uuid"
+// @Success 201 {object} models.Comment
+// @Router /comments/{comment_id}/replies [post]
+func (h *commentsHandlers) CreateReply() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.CreateReply")
+		defer span.Finish()
+
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var req models.ReplyRequest
+		if err := c.Bind(&req); err != nil {
+			h.logger.Error("c.Bind")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &req); err != nil {
+			h.logger.Error("validate.StructCtx")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		reply, err := h.commUC.CreateReply(ctx, commUUID, req.Message)
+		if err != nil {
+			h.logger.Error("commUC.CreateReply")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusCreated, reply)
+	}
+}
+
+// Register UpdateReply
+// @Tags Comments
+// @Summary Update reply by id
+// @Description Update reply message, allowed for hotel owner or admin
+// @Accept json
+// @Produce json
+// @Param reply_id path string true "reply uuid"
+// @Success 200 {object} models.Comment
+// @Router /comments/replies/{reply_id} [put]
+func (h *commentsHandlers) UpdateReply() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.UpdateReply")
+		defer span.Finish()
+
+		replyUUID, err := uuid.FromString(c.Param("reply_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var req models.ReplyRequest
+		if err := c.Bind(&req); err != nil {
+			h.logger.Error("c.Bind")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &req); err != nil {
+			h.logger.Error("validate.StructCtx")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		reply, err := h.commUC.UpdateReply(ctx, replyUUID, req.Message)
+		if err != nil {
+			h.logger.Error("commUC.UpdateReply")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, reply)
+	}
+}
+
+// Register VoteHelpful
+// @Tags Comments
+// @Summary Vote comment as helpful
+// @Description Up-vote comment as helpful, one vote per user
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "comment uuid"
+// @Success 200 {object} models.HelpfulVote
+// @Router /comments/{comment_id}/helpful [post]
+func (h *commentsHandlers) VoteHelpful() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.VoteHelpful")
+		defer span.Finish()
+
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		vote, err := h.commUC.VoteHelpful(ctx, commUUID)
+		if err != nil {
+			h.logger.Error("commUC.VoteHelpful")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, vote)
+	}
+}
+
+// Register ReportComment
+// @Tags Comments
+// @Summary Report comment abuse
+// @Description Report comment abuse, comment is hidden pending admin review when reports reach threshold
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "comment uuid"
+// @Success 202 {string} string "ok"
+// @Router /comments/{comment_id}/reports [post]
+func (h *commentsHandlers) ReportComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.ReportComment")
+		defer span.Finish()
+
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var req models.ReportRequest
+		if err := c.Bind(&req); err != nil {
+			h.logger.Error("c.Bind")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &req); err != nil {
+			h.logger.Error("validate.StructCtx")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.commUC.ReportComment(ctx, commUUID, req.Reason); err != nil {
+			h.logger.Error("commUC.ReportComment")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+}
+
+// Register GetHiddenComments
+// @Tags Comments
+// @Summary Get hidden comments
+// @Description Get comments hidden by abuse reports pending review, admin only
+// @Accept json
+// @Produce json
+// @Param page query int false "page number"
+// @Param size query int true "number of elements"
+// @Param cursor query string false "next page cursor"
+// @Success 200 {object} models.UserCommentsList
+// @Router /comments/hidden [get]
+func (h *commentsHandlers) GetHiddenComments() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.GetHiddenComments")
+		defer span.Finish()
+
+		var query models.CommentsQuery
+		if err := c.Bind(&query); err != nil {
+			h.logger.Error("c.Bind")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &query); err != nil {
+			h.logger.Error("validate.StructCtx")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		commentsList, err := h.commUC.GetHiddenComments(ctx, &query)
+		if err != nil {
+			h.logger.Error("commUC.GetHiddenComments")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, commentsList)
+	}
+}
+
+// Register ReviewComment
+// @Tags Comments
+// @Summary Review hidden comment
+// @Description Approve hidden comment to show it again or reject to delete it, admin only
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "comment uuid"
+// @Success 200 {string} string "ok"
+// @Router /comments/{comment_id}/review [post]
+func (h *commentsHandlers) ReviewComment() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.ReviewComment")
+		defer span.Finish()
+
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var req models.ReviewRequest
+		if err := c.Bind(&req); err != nil {
+			h.logger.Error("c.Bind")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.commUC.ReviewComment(ctx, commUUID, req.Approve); err != nil {
+			h.logger.Error("commUC.ReviewComment")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// Register UploadPhoto
+// @Tags Comments
+// @Summary Upload comment photo
+// @Description Upload comment photo, processed photo is attached to comment asynchronously
+// @Accept mpfd
+// @Produce json
+// @Param comment_id path string true "comment uuid"
+// @Param photo formData file true "photo file"
+// @Success 202 {string} string "accepted"
+// @Router /comments/{comment_id}/photos [post]
+func (h *commentsHandlers) UploadPhoto() echo.HandlerFunc {
+	bufferPool := &sync.Pool{New: func() interface{} {
+		return &bytes.Buffer{}
+	}}
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.UploadPhoto")
+		defer span.Finish()
+
+		commUUID, err := uuid.FromString(c.Param("comment_id"))
+		if err != nil {
+			h.logger.Error("uuid.FromString")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxFileSize)
+		defer c.Request().Body.Close()
+
+		if err := c.Request().ParseMultipartForm(maxFileSize); err != nil {
+			h.logger.Error("c.ParseMultipartForm")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		formFile, _, err := c.Request().FormFile("photo")
+		if err != nil {
+			h.logger.Error("c.FormFile")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		fileType, err := utils.CheckImageUpload(formFile)
+		if err != nil {
+			h.logger.Error("utils.CheckImageUpload")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		buf, ok := bufferPool.Get().(*bytes.Buffer)
+		if !ok {
+			h.logger.Error("bufferPool.Get")
+			return httpErrors.ErrorCtxResponse(c, httpErrors.InternalServerError)
+		}
+		defer bufferPool.Put(buf)
+		buf.Reset()
+
+		if _, err := io.Copy(buf, formFile); err != nil {
+			h.logger.Error("io.Copy")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.commUC.UploadPhoto(ctx, commUUID, buf.Bytes(), fileType); err != nil {
+			h.logger.Error("commUC.UploadPhoto")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+}
+
 // Register GetByHotelID
 // @Tags Comments
 // @Summary Get comments by hotel id
 // @Description Get comments list by hotel uuid
 // @Accept json
 // @Produce json
-// @Param hotel_id query string false "hotel uuid"
+// @Param hotel_id path string true "hotel uuid"
+// @Param page query int false "page number"
+// @Param size query int true "number of elements"
+// @Param cursor query string false "next page cursor"
+// @Param sort query string false "newest, oldest, highest, lowest or helpful"
+// @Param min_rating query number false "min rating"
+// @Param max_rating query number false "max rating"
 // @Success 200 {object} models.CommentsList
 // @Router /comments/hotel/{hotel_id} [get]
 func (h *commentsHandlers) GetByHotelID() echo.HandlerFunc {
@@ -159,24 +491,24 @@ func (h *commentsHandlers) GetByHotelID() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.GetByHotelID")
 		defer span.Finish()
 
-		hotelUUID, err := uuid.FromString(c.QueryParam("hotel_id"))
+		hotelUUID, err := uuid.FromString(c.Param("hotel_id"))
 		if err != nil {
 			h.logger.Error("uuid.FromString")
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		page, err := strconv.Atoi(c.QueryParam("page"))
-		if err != nil {
-			h.logger.Error("strconv.Atoi")
+		var query models.CommentsQuery
+		if err := c.Bind(&query); err != nil {
+			h.logger.Error("c.Bind")
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
-		size, err := strconv.Atoi(c.QueryParam("size"))
-		if err != nil {
-			h.logger.Error("strconv.Atoi")
+
+		if err := h.validate.StructCtx(ctx, &query); err != nil {
+			h.logger.Error("validate.StructCtx")
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		commentsList, err := h.commUC.GetByHotelID(ctx, hotelUUID, int64(page), int64(size))
+		commentsList, err := h.commUC.GetByHotelID(ctx, hotelUUID, &query)
 		if err != nil {
 			h.logger.Error("commUC.GetByHotelID")
 			return httpErrors.ErrorCtxResponse(c, err)
@@ -185,3 +517,41 @@ func (h *commentsHandlers) GetByHotelID() echo.HandlerFunc {
 		return c.JSON(http.StatusOK, commentsList)
 	}
 }
+
+// Register GetMyComments
+// @Tags Comments
+// @Summary Get current user comments
+// @Description Get comments list of the logged in user
+// @Accept json
+// @Produce json
+// @Param page query int false "page number"
+// @Param size query int true "number of elements"
+// @Param cursor query string false "next page cursor"
+// @Param sort query string false "newest, oldest, highest, lowest or helpful"
+// @Success 200 {object} models.UserCommentsList
+// @Router /comments/me [get]
+func (h *commentsHandlers) GetMyComments() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "commentsHandlers.GetMyComments")
+		defer span.Finish()
+
+		var query models.CommentsQuery
+		if err := c.Bind(&query); err != nil {
+			h.logger.Error("c.Bind")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &query); err != nil {
+			h.logger.Error("validate.StructCtx")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		commentsList, err := h.commUC.GetMyComments(ctx, &query)
+		if err != nil {
+			h.logger.Error("commUC.GetMyComments")
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, commentsList)
+	}
+}
@@ -1,9 +1,20 @@
 package v1
 
+import userService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/user"
+
 // MapRoutes
 func (c *commentsHandlers) MapRoutes() {
+	c.group.GET("/me", c.GetMyComments(), c.mw.SessionMiddleware)
+	c.group.GET("/hidden", c.GetHiddenComments(), c.mw.SessionMiddleware, c.mw.RequirePermission(userService.Permission_COMMENT_MODERATE))
+	c.group.GET("/hotel/:hotel_id", c.GetByHotelID())
 	c.group.GET("/:comment_id", c.GetCommByID())
-	c.group.POST("", c.CreateComment(), c.mw.SessionMiddleware)
+	c.group.POST("", c.CreateComment(), c.mw.SessionMiddleware, c.mw.VerifiedEmailMiddleware)
 	c.group.PUT("/:comment_id", c.UpdateComment(), c.mw.SessionMiddleware)
-	c.group.PUT("/comments/hotel/:hotel_id", c.GetByHotelID())
+	c.group.DELETE("/:comment_id", c.DeleteComment(), c.mw.SessionMiddleware)
+	c.group.POST("/:comment_id/photos", c.UploadPhoto(), c.mw.SessionMiddleware)
+	c.group.POST("/:comment_id/replies", c.CreateReply(), c.mw.SessionMiddleware, c.mw.VerifiedEmailMiddleware)
+	c.group.PUT("/replies/:reply_id", c.UpdateReply(), c.mw.SessionMiddleware)
+	c.group.POST("/:comment_id/helpful", c.VoteHelpful(), c.mw.SessionMiddleware)
+	c.group.POST("/:comment_id/reports", c.ReportComment(), c.mw.SessionMiddleware)
+	c.group.POST("/:comment_id/review", c.ReviewComment(), c.mw.SessionMiddleware, c.mw.RequirePermission(userService.Permission_COMMENT_MODERATE))
 }
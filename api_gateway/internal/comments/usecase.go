@@ -13,5 +13,14 @@ type UseCase interface {
 	CreateComment(ctx context.Context, comment *models.Comment) (*models.Comment, error)
 	GetCommByID(ctx context.Context, commentID uuid.UUID) (*models.Comment, error)
 	UpdateComment(ctx context.Context, comment *models.Comment) (*models.Comment, error)
-	GetByHotelID(ctx context.Context, hotelID uuid.UUID, page, size int64) (*models.CommentsList, error)
+	DeleteComment(ctx context.Context, commentID uuid.UUID) error
+	CreateReply(ctx context.Context, parentID uuid.UUID, message string) (*models.Comment, error)
+	UpdateReply(ctx context.Context, replyID uuid.UUID, message string) (*models.Comment, error)
+	UploadPhoto(ctx context.Context, commentID uuid.UUID, data []byte, contentType string) error
+	GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *models.CommentsQuery) (*models.CommentsList, error)
+	GetMyComments(ctx context.Context, query *models.CommentsQuery) (*models.UserCommentsList, error)
+	VoteHelpful(ctx context.Context, commentID uuid.UUID) (*models.HelpfulVote, error)
+	ReportComment(ctx context.Context, commentID uuid.UUID, reason string) error
+	GetHiddenComments(ctx context.Context, query *models.CommentsQuery) (*models.UserCommentsList, error)
+	ReviewComment(ctx context.Context, commentID uuid.UUID, approve bool) error
 }
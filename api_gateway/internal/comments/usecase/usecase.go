@@ -11,6 +11,7 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/comments"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/middlewares"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/grpc_client"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/logger"
 	commentsService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/comments"
@@ -38,9 +39,8 @@ func (c *commentUseCase) CreateComment(ctx context.Context, comment *models.Comm
 		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
 	}
 
-	commentRes, err := c.commService.CreateComment(ctx, &commentsService.CreateCommentReq{
+	commentRes, err := c.commService.CreateComment(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &commentsService.CreateCommentReq{
 		HotelID: comment.HotelID.String(),
-		UserID:  ctxUser.UserID.String(),
 		Message: comment.Message,
 		Photos:  comment.Photos,
 		Rating:  comment.Rating,
@@ -91,7 +91,7 @@ func (c *commentUseCase) GetCommByID(ctx context.Context, commentID uuid.UUID) (
 
 // UpdateComment
 func (c *commentUseCase) UpdateComment(ctx context.Context, comment *models.Comment) (*models.Comment, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.CreateComment")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.UpdateComment")
 	defer span.Finish()
 
 	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
@@ -99,18 +99,14 @@ func (c *commentUseCase) UpdateComment(ctx context.Context, comment *models.Comm
 		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
 	}
 
-	if ctxUser.UserID != comment.UserID {
-		return nil, errors.Wrap(httpErrors.WrongCredentials, "user is not owner")
-	}
-
-	commRes, err := c.commService.UpdateComment(ctx, &commentsService.UpdateCommReq{
+	commRes, err := c.commService.UpdateComment(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &commentsService.UpdateCommReq{
 		CommentID: comment.CommentID.String(),
 		Message:   comment.Message,
 		Photos:    comment.Photos,
 		Rating:    comment.Rating,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "CommentFromProto")
+		return nil, errors.Wrap(err, "commService.UpdateComment")
 	}
 
 	comm, err := models.CommentFromProto(commRes.GetComment())
@@ -125,15 +121,232 @@ func (c *commentUseCase) UpdateComment(ctx context.Context, comment *models.Comm
 	return comm, nil
 }
 
+// DeleteComment
+func (c *commentUseCase) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.DeleteComment")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	if _, err := c.commService.DeleteComment(
+		grpc_client.WithUserID(ctx, ctxUser.UserID.String()),
+		&commentsService.DeleteCommentReq{CommentID: commentID.String()},
+	); err != nil {
+		return errors.Wrap(err, "commService.DeleteComment")
+	}
+
+	if err := c.commRepo.DeleteComment(ctx, commentID); err != nil {
+		c.logger.Errorf("DeleteComment: %v", err)
+	}
+
+	return nil
+}
+
+// CreateReply
+func (c *commentUseCase) CreateReply(ctx context.Context, parentID uuid.UUID, message string) (*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.CreateReply")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	replyRes, err := c.commService.CreateReply(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &commentsService.CreateReplyReq{
+		ParentCommentID: parentID.String(),
+		Message:         message,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "commService.CreateReply")
+	}
+
+	reply, err := models.CommentFromProto(replyRes.GetComment())
+	if err != nil {
+		return nil, errors.Wrap(err, "CommentFromProto")
+	}
+
+	return reply, nil
+}
+
+// UpdateReply
+func (c *commentUseCase) UpdateReply(ctx context.Context, replyID uuid.UUID, message string) (*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.UpdateReply")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	replyRes, err := c.commService.UpdateReply(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &commentsService.UpdateReplyReq{
+		CommentID: replyID.String(),
+		Message:   message,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "commService.UpdateReply")
+	}
+
+	reply, err := models.CommentFromProto(replyRes.GetComment())
+	if err != nil {
+		return nil, errors.Wrap(err, "CommentFromProto")
+	}
+
+	if err := c.commRepo.SetComment(ctx, reply); err != nil {
+		c.logger.Errorf("SetComment: %v", err)
+	}
+
+	return reply, nil
+}
+
+// UploadPhoto
+func (c *commentUseCase) UploadPhoto(ctx context.Context, commentID uuid.UUID, data []byte, contentType string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.UploadPhoto")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	if _, err := c.commService.UploadPhoto(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &commentsService.UploadPhotoReq{
+		CommentID:   commentID.String(),
+		Data:        data,
+		ContentType: contentType,
+	}); err != nil {
+		return errors.Wrap(err, "commService.UploadPhoto")
+	}
+
+	return nil
+}
+
+// VoteHelpful
+func (c *commentUseCase) VoteHelpful(ctx context.Context, commentID uuid.UUID) (*models.HelpfulVote, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.VoteHelpful")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	res, err := c.commService.VoteHelpful(
+		grpc_client.WithUserID(ctx, ctxUser.UserID.String()),
+		&commentsService.VoteHelpfulReq{CommentID: commentID.String()},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "commService.VoteHelpful")
+	}
+
+	if err := c.commRepo.DeleteComment(ctx, commentID); err != nil {
+		c.logger.Errorf("DeleteComment: %v", err)
+	}
+
+	return &models.HelpfulVote{CommentID: commentID, HelpfulCount: res.GetHelpfulCount()}, nil
+}
+
+// ReportComment
+func (c *commentUseCase) ReportComment(ctx context.Context, commentID uuid.UUID, reason string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.ReportComment")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	if _, err := c.commService.ReportComment(
+		grpc_client.WithUserID(ctx, ctxUser.UserID.String()),
+		&commentsService.ReportCommentReq{CommentID: commentID.String(), Reason: reason},
+	); err != nil {
+		return errors.Wrap(err, "commService.ReportComment")
+	}
+
+	if err := c.commRepo.DeleteComment(ctx, commentID); err != nil {
+		c.logger.Errorf("DeleteComment: %v", err)
+	}
+
+	return nil
+}
+
+// GetHiddenComments comments hidden by abuse reports pending admin review
+func (c *commentUseCase) GetHiddenComments(ctx context.Context, query *models.CommentsQuery) (*models.UserCommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.GetHiddenComments")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	res, err := c.commService.GetHidden(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &commentsService.GetHiddenReq{
+		Page:   query.Page,
+		Size:   query.Size,
+		Cursor: query.Cursor,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "commService.GetHidden")
+	}
+
+	commList := make([]*models.Comment, 0, len(res.Comments))
+	for _, comment := range res.Comments {
+		comm, err := models.CommentFromProto(comment)
+		if err != nil {
+			return nil, errors.Wrap(err, "CommentFromProto")
+		}
+		commList = append(commList, comm)
+	}
+
+	return &models.UserCommentsList{
+		TotalCount: res.GetTotalCount(),
+		TotalPages: res.GetTotalPages(),
+		Page:       res.GetPage(),
+		Size:       res.GetSize(),
+		HasMore:    res.GetHasMore(),
+		NextCursor: res.GetNextCursor(),
+		Comments:   commList,
+	}, nil
+}
+
+// ReviewComment
+func (c *commentUseCase) ReviewComment(ctx context.Context, commentID uuid.UUID, approve bool) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.ReviewComment")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	if _, err := c.commService.ReviewComment(
+		grpc_client.WithUserID(ctx, ctxUser.UserID.String()),
+		&commentsService.ReviewCommentReq{CommentID: commentID.String(), Approve: approve},
+	); err != nil {
+		return errors.Wrap(err, "commService.ReviewComment")
+	}
+
+	if err := c.commRepo.DeleteComment(ctx, commentID); err != nil {
+		c.logger.Errorf("DeleteComment: %v", err)
+	}
+
+	return nil
+}
+
 // GetByHotelID
-func (c *commentUseCase) GetByHotelID(ctx context.Context, hotelID uuid.UUID, page, size int64) (*models.CommentsList, error) {
+func (c *commentUseCase) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *models.CommentsQuery) (*models.CommentsList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.GetByHotelID")
 	defer span.Finish()
 
 	res, err := c.commService.GetByHotelID(ctx, &commentsService.GetByHotelReq{
-		HotelID: hotelID.String(),
-		Page:    page,
-		Size:    size,
+		HotelID:   hotelID.String(),
+		Page:      query.Page,
+		Size:      query.Size,
+		Cursor:    query.Cursor,
+		Sort:      query.Sort,
+		MinRating: query.MinRating,
+		MaxRating: query.MaxRating,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "CommentFromProto")
@@ -154,6 +367,48 @@ func (c *commentUseCase) GetByHotelID(ctx context.Context, hotelID uuid.UUID, pa
 		Page:       res.GetPage(),
 		Size:       res.GetSize(),
 		HasMore:    res.GetHasMore(),
+		NextCursor: res.GetNextCursor(),
+		Comments:   commList,
+	}, nil
+}
+
+// GetMyComments
+func (c *commentUseCase) GetMyComments(ctx context.Context, query *models.CommentsQuery) (*models.UserCommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commentUseCase.GetMyComments")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	res, err := c.commService.GetByUserID(ctx, &commentsService.GetByUserReq{
+		UserID: ctxUser.UserID.String(),
+		Page:   query.Page,
+		Size:   query.Size,
+		Cursor: query.Cursor,
+		Sort:   query.Sort,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "commService.GetByUserID")
+	}
+
+	commList := make([]*models.Comment, 0, len(res.Comments))
+	for _, comment := range res.Comments {
+		comm, err := models.CommentFromProto(comment)
+		if err != nil {
+			return nil, errors.Wrap(err, "CommentFromProto")
+		}
+		commList = append(commList, comm)
+	}
+
+	return &models.UserCommentsList{
+		TotalCount: res.GetTotalCount(),
+		TotalPages: res.GetTotalPages(),
+		Page:       res.GetPage(),
+		Size:       res.GetSize(),
+		HasMore:    res.GetHasMore(),
+		NextCursor: res.GetNextCursor(),
 		Comments:   commList,
 	}, nil
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"io"
 	"net/http"
-	"strconv"
 	"sync"
 
 	"github.com/go-playground/validator/v10"
@@ -50,7 +49,7 @@ func NewHotelsHandlers(
 // Register CreateHotel
 // @Tags Hotels
 // @Summary Create new hotel
-// @Description Create new hotel instance
+// @Description Create new hotel instance, allowed for hotel owners and admins
 // @Accept json
 // @Produce json
 // @Success 201 {object} models.Hotel
@@ -84,7 +83,7 @@ func (h *hotelsHandlers) CreateHotel() echo.HandlerFunc {
 // Register UpdateHotel
 // @Tags Hotels
 // @Summary Update hotel data
-// @Description Update single hotel data
+// @Description Update single hotel data, allowed for hotel owner or admin
 // @Accept json
 // @Produce json
 // @Param hotel_id path int true "Hotel UUID"
@@ -156,11 +155,21 @@ func (h *hotelsHandlers) GetHotelByID() echo.HandlerFunc {
 // Register GetHotels
 // @Tags Hotels
 // @Summary Get hotels list new user
-// @Description Get hotels list with pagination using page and size query parameters
+// @Description Get hotels list with pagination using page and size or cursor query parameters, sorting and filters, next cursor is returned and accepted only for created_at sort
 // @Accept json
 // @Produce json
 // @Param page query int false "page number"
 // @Param size query int false "number of elements"
+// @Param cursor query string false "next page cursor, only with created_at sort"
+// @Param sort_by query string false "rating, name, created_at, comments_count or distance"
+// @Param sort_order query string false "asc or desc"
+// @Param country query string false "country"
+// @Param city query string false "city"
+// @Param min_rating query number false "min rating"
+// @Param max_rating query number false "max rating"
+// @Param has_image query bool false "only hotels with image"
+// @Param latitude query number false "latitude for distance sort"
+// @Param longitude query number false "longitude for distance sort"
 // @Success 200 {object} models.HotelsListRes
 // @Router /hotels [get]
 func (h *hotelsHandlers) GetHotels() echo.HandlerFunc {
@@ -168,18 +177,18 @@ func (h *hotelsHandlers) GetHotels() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "hotelsHandlers.GetHotels")
 		defer span.Finish()
 
-		page, err := strconv.Atoi(c.QueryParam("page"))
-		if err != nil {
-			h.logger.Error("strconv.Atoi")
+		var query models.HotelsQuery
+		if err := c.Bind(&query); err != nil {
+			h.logger.Error("c.Bind")
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
-		size, err := strconv.Atoi(c.QueryParam("size"))
-		if err != nil {
-			h.logger.Error("strconv.Atoi")
+
+		if err := h.validate.StructCtx(ctx, &query); err != nil {
+			h.logger.Error("validate.StructCtx")
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		hotelsList, err := h.hotelsUC.GetHotels(ctx, int64(page), int64(size))
+		hotelsList, err := h.hotelsUC.GetHotels(ctx, &query)
 		if err != nil {
 			h.logger.Error("hotelsUC.GetHotels")
 			return httpErrors.ErrorCtxResponse(c, err)
@@ -192,7 +201,7 @@ func (h *hotelsHandlers) GetHotels() echo.HandlerFunc {
 // UploadImage godoc
 // @Summary Upload hotel image
 // @Tags Hotels
-// @Description Upload hotel logo image
+// @Description Upload hotel logo image, allowed for hotel owner or admin
 // @Accept mpfd
 // @Produce json
 // @Param hotel_id query string false "hotel uuid"
@@ -1,10 +1,12 @@
 package v1
 
+import userService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/user"
+
 // MapRoutes
 func (h *hotelsHandlers) MapRoutes() {
 	h.group.GET("", h.GetHotels())
 	h.group.GET("/:hotel_id", h.GetHotelByID())
-	h.group.POST("", h.CreateHotel(), h.mw.SessionMiddleware)
-	h.group.PUT("/:hotel_id", h.UpdateHotel(), h.mw.SessionMiddleware)
-	h.group.PUT("/:hotel_id/image", h.UploadImage(), h.mw.SessionMiddleware)
+	h.group.POST("", h.CreateHotel(), h.mw.SessionMiddleware, h.mw.VerifiedEmailMiddleware, h.mw.RequirePermission(userService.Permission_HOTEL_CREATE))
+	h.group.PUT("/:hotel_id", h.UpdateHotel(), h.mw.SessionMiddleware, h.mw.RequirePermission(userService.Permission_HOTEL_UPDATE_OWN))
+	h.group.PUT("/:hotel_id/image", h.UploadImage(), h.mw.SessionMiddleware, h.mw.RequirePermission(userService.Permission_HOTEL_UPDATE_OWN))
 }
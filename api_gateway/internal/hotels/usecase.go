@@ -10,7 +10,7 @@ import (
 
 // UseCase
 type UseCase interface {
-	GetHotels(ctx context.Context, page, size int64) (*models.HotelsListRes, error)
+	GetHotels(ctx context.Context, query *models.HotelsQuery) (*models.HotelsListRes, error)
 	GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error)
 	UpdateHotel(ctx context.Context, hotel *models.Hotel) (*models.Hotel, error)
 	CreateHotel(ctx context.Context, hotel *models.Hotel) (*models.Hotel, error)
@@ -11,6 +11,7 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/hotels"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/middlewares"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/grpc_client"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/logger"
 	hotelsService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/hotels"
@@ -70,7 +71,12 @@ func (h *hotelsUseCase) UpdateHotel(ctx context.Context, hotel *models.Hotel) (*
 	span, ctx := opentracing.StartSpanFromContext(ctx, "HotelsUseCase.UpdateHotel")
 	defer span.Finish()
 
-	hotelRes, err := h.hotelsService.UpdateHotel(ctx, &hotelsService.UpdateHotelReq{
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	hotelRes, err := h.hotelsService.UpdateHotel(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &hotelsService.UpdateHotelReq{
 		HotelID:       hotel.HotelID.String(),
 		Name:          hotel.Name,
 		Email:         hotel.Email,
@@ -106,7 +112,12 @@ func (h *hotelsUseCase) CreateHotel(ctx context.Context, hotel *models.Hotel) (*
 	span, ctx := opentracing.StartSpanFromContext(ctx, "HotelsUseCase.CreateHotel")
 	defer span.Finish()
 
-	hotelRes, err := h.hotelsService.CreateHotel(ctx, &hotelsService.CreateHotelReq{
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	hotelRes, err := h.hotelsService.CreateHotel(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &hotelsService.CreateHotelReq{
 		Name:          hotel.Name,
 		Email:         hotel.Email,
 		Country:       hotel.Country,
@@ -119,6 +130,7 @@ func (h *hotelsUseCase) CreateHotel(ctx context.Context, hotel *models.Hotel) (*
 		CommentsCount: int64(hotel.CommentsCount),
 		Latitude:      *hotel.Latitude,
 		Longitude:     *hotel.Longitude,
+		OwnerID:       ctxUser.UserID.String(),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "hotelsService.CreateHotel")
@@ -137,7 +149,12 @@ func (h *hotelsUseCase) UploadImage(ctx context.Context, data []byte, contentTyp
 	span, ctx := opentracing.StartSpanFromContext(ctx, "HotelsUseCase.UploadImage")
 	defer span.Finish()
 
-	_, err := h.hotelsService.UploadImage(ctx, &hotelsService.UploadImageReq{
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok || ctxUser == nil {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	_, err := h.hotelsService.UploadImage(grpc_client.WithUserID(ctx, ctxUser.UserID.String()), &hotelsService.UploadImageReq{
 		HotelID:     hotelID,
 		Data:        data,
 		ContentType: contentType,
@@ -150,13 +167,23 @@ func (h *hotelsUseCase) UploadImage(ctx context.Context, data []byte, contentTyp
 }
 
 // GetHotelsGetHotels
-func (h *hotelsUseCase) GetHotels(ctx context.Context, page, size int64) (*models.HotelsListRes, error) {
+func (h *hotelsUseCase) GetHotels(ctx context.Context, query *models.HotelsQuery) (*models.HotelsListRes, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "HotelsUseCase.GetHotels")
 	defer span.Finish()
 
 	hotelsRes, err := h.hotelsService.GetHotels(ctx, &hotelsService.GetHotelsReq{
-		Page: page,
-		Size: size,
+		Page:      query.Page,
+		Size:      query.Size,
+		Cursor:    query.Cursor,
+		SortBy:    query.SortBy,
+		SortOrder: query.SortOrder,
+		Country:   query.Country,
+		City:      query.City,
+		MinRating: query.MinRating,
+		MaxRating: query.MaxRating,
+		HasImage:  query.HasImage,
+		Latitude:  query.Latitude,
+		Longitude: query.Longitude,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "hotelsService.GetHotels")
@@ -177,6 +204,7 @@ func (h *hotelsUseCase) GetHotels(ctx context.Context, page, size int64) (*model
 		Page:       hotelsRes.GetPage(),
 		Size:       hotelsRes.GetSize(),
 		HasMore:    hotelsRes.GetHasMore(),
+		NextCursor: hotelsRes.GetNextCursor(),
 		Hotels:     hotelsList,
 	}, nil
 }
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo/v4"
 	"github.com/opentracing/opentracing-go"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/config"
+	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/internal/user"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/api-gateway/pkg/logger"
+	userService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/user"
 )
 
 // MiddlewareManager
@@ -32,12 +38,40 @@ type RequestCtxUser struct{}
 // Request Ctx Session key
 type RequestCtxSession struct{}
 
-// SessionMiddleware
+const bearerPrefix = "Bearer "
+
+// SessionMiddleware authenticate request by bearer access token or session cookie
 func (m *MiddlewareManager) SessionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.SessionMiddleware")
 		defer span.Finish()
 
+		if accessToken, ok := bearerToken(c); ok {
+			sess, err := m.parseAccessToken(accessToken)
+			if err != nil {
+				m.logger.Errorf("SessionMiddleware.parseAccessToken: %v", err)
+				return httpErrors.ErrorCtxResponse(c, httpErrors.InvalidJWTToken)
+			}
+
+			userResponse, err := m.userUC.GetByID(ctx, sess.UserID)
+			if err != nil {
+				m.logger.Errorf("SessionMiddleware.userUC.GetByID: %v", err)
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+			if userResponse.IsSuspended() {
+				return httpErrors.ErrorCtxResponse(c, httpErrors.AccountSuspended)
+			}
+			if userResponse.IsAdmin() && !userResponse.IsTwoFactorEnabled() {
+				return httpErrors.ErrorCtxResponse(c, httpErrors.TwoFactorEnrollmentRequired)
+			}
+
+			ctx = context.WithValue(c.Request().Context(), RequestCtxUser{}, userResponse)
+			ctx = context.WithValue(ctx, RequestCtxSession{}, sess)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+
 		cookie, err := c.Cookie(m.cfg.HttpServer.SessionCookieName)
 		if err != nil {
 			if errors.Is(err, http.ErrNoCookie) {
@@ -60,6 +94,29 @@ func (m *MiddlewareManager) SessionMiddleware(next echo.HandlerFunc) echo.Handle
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
+		// suspended users are rejected even while session or access token is still valid
+		if userResponse.IsSuspended() {
+			m.logger.Warnf("SessionMiddleware suspended user: %s", userResponse.UserID.String())
+			return httpErrors.ErrorCtxResponse(c, httpErrors.AccountSuspended)
+		}
+
+		// admins must enroll two-factor in user service before using gateway routes
+		if userResponse.IsAdmin() && !userResponse.IsTwoFactorEnabled() {
+			return httpErrors.ErrorCtxResponse(c, httpErrors.TwoFactorEnrollmentRequired)
+		}
+
+		if sessionByID.Renewed {
+			c.SetCookie(&http.Cookie{
+				Name:     m.cfg.HttpServer.SessionCookieName,
+				Value:    sessionByID.SessionID,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   m.cfg.HttpServer.CookieSecure,
+				SameSite: http.SameSiteLaxMode,
+				Expires:  sessionByID.ExpiresAt,
+			})
+		}
+
 		ctx = context.WithValue(c.Request().Context(), RequestCtxUser{}, userResponse)
 		ctx = context.WithValue(ctx, RequestCtxSession{}, sessionByID)
 		c.SetRequest(c.Request().WithContext(ctx))
@@ -67,3 +124,122 @@ func (m *MiddlewareManager) SessionMiddleware(next echo.HandlerFunc) echo.Handle
 		return next(c)
 	}
 }
+
+// VerifiedEmailMiddleware allow only users with verified email, must be used after SessionMiddleware
+func (m *MiddlewareManager) VerifiedEmailMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userResponse, ok := c.Request().Context().Value(RequestCtxUser{}).(*models.UserResponse)
+		if !ok {
+			m.logger.Error("VerifiedEmailMiddleware invalid middleware user ctx")
+			return httpErrors.ErrorCtxResponse(c, httpErrors.Unauthorized)
+		}
+
+		if !userResponse.IsEmailVerified() {
+			return httpErrors.ErrorCtxResponse(c, httpErrors.EmailNotVerified)
+		}
+
+		return next(c)
+	}
+}
+
+// RequirePermission allow only users granted all given permissions by user service, must be used after SessionMiddleware
+func (m *MiddlewareManager) RequirePermission(permissions ...userService.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userResponse, ok := c.Request().Context().Value(RequestCtxUser{}).(*models.UserResponse)
+			if !ok {
+				m.logger.Error("RequirePermission invalid middleware user ctx")
+				return httpErrors.ErrorCtxResponse(c, httpErrors.Unauthorized)
+			}
+
+			if !userResponse.HasPermission(permissions...) {
+				m.logger.Warnf("RequirePermission user: %s permissions: %v denied", userResponse.UserID.String(), permissions)
+				return httpErrors.ErrorCtxResponse(c, httpErrors.PermissionDenied)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// CSRFMiddleware validate csrf token of session on unsafe methods, allowlisted routes are skipped
+func (m *MiddlewareManager) CSRFMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	allowlist := make(map[string]struct{}, len(m.cfg.HttpServer.CSRFAllowlist))
+	for _, path := range m.cfg.HttpServer.CSRFAllowlist {
+		allowlist[path] = struct{}{}
+	}
+
+	return func(c echo.Context) error {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+			return next(c)
+		}
+		if _, ok := allowlist[c.Path()]; ok {
+			return next(c)
+		}
+		// bearer tokens are not sent by browser automatically
+		if _, ok := bearerToken(c); ok {
+			return next(c)
+		}
+
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "mw.CSRFMiddleware")
+		defer span.Finish()
+
+		token := c.Request().Header.Get(m.cfg.HttpServer.CSRFHeader)
+		if token == "" {
+			m.logger.Errorf("CSRFMiddleware token not presented")
+			return httpErrors.ErrorCtxResponse(c, httpErrors.CSRFNotPresented)
+		}
+
+		cookie, err := c.Cookie(m.cfg.HttpServer.SessionCookieName)
+		if err != nil {
+			m.logger.Errorf("CSRFMiddleware.c.Cookie: %v", err)
+			return httpErrors.ErrorCtxResponse(c, httpErrors.Unauthorized)
+		}
+
+		isValid, err := m.userUC.CheckCSRFToken(ctx, cookie.Value, token)
+		if err != nil || !isValid {
+			m.logger.Errorf("CSRFMiddleware.CheckCSRFToken: %v", err)
+			return httpErrors.ErrorCtxResponse(c, httpErrors.WrongCSRFToken)
+		}
+
+		return next(c)
+	}
+}
+
+// parseAccessToken validate HS256 signed access token and map its claims to session,
+// session id is refresh token family the access token was issued for
+func (m *MiddlewareManager) parseAccessToken(accessToken string) (*models.Session, error) {
+	claims := &models.AccessTokenClaims{}
+	token, err := jwt.ParseWithClaims(accessToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.New("unexpected jwt signing method")
+		}
+		return []byte(m.cfg.JWT.Secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid || !claims.VerifyIssuer(m.cfg.JWT.Issuer, true) {
+		return nil, httpErrors.InvalidJWTClaims
+	}
+
+	userID, err := uuid.FromString(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Session{
+		UserID:    userID,
+		SessionID: claims.FamilyID,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0).UTC(),
+	}, nil
+}
+
+func bearerToken(c echo.Context) (string, bool) {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(header, bearerPrefix), true
+}
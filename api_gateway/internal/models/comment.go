@@ -19,6 +19,31 @@ type Comment struct {
 	Rating    float64    `json:"rating" validate:"required,min=0,max=10"`
 	CreatedAt *time.Time `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at"`
+
+	ParentCommentID *uuid.UUID `json:"parent_comment_id,omitempty"`
+	HelpfulCount    int64      `json:"helpful_count"`
+	Hidden          bool       `json:"hidden"`
+}
+
+// ReportRequest comment abuse report
+type ReportRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=250"`
+}
+
+// ReviewRequest admin decision on comment hidden by abuse reports
+type ReviewRequest struct {
+	Approve bool `json:"approve"`
+}
+
+// HelpfulVote comment helpful votes count after user vote
+type HelpfulVote struct {
+	CommentID    uuid.UUID `json:"comment_id"`
+	HelpfulCount int64     `json:"helpful_count"`
+}
+
+// ReplyRequest hotel owner reply to comment
+type ReplyRequest struct {
+	Message string `json:"message" validate:"required,min=5,max=500"`
 }
 
 // All Comments response with pagination
@@ -28,9 +53,31 @@ type CommentsList struct {
 	Page       int64          `json:"page"`
 	Size       int64          `json:"size"`
 	HasMore    bool           `json:"hasMore"`
+	NextCursor string         `json:"nextCursor,omitempty"`
 	Comments   []*CommentFull `json:"comments"`
 }
 
+// UserCommentsList current user comments with pagination
+type UserCommentsList struct {
+	TotalCount int64      `json:"totalCount"`
+	TotalPages int64      `json:"totalPages"`
+	Page       int64      `json:"page"`
+	Size       int64      `json:"size"`
+	HasMore    bool       `json:"hasMore"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+	Comments   []*Comment `json:"comments"`
+}
+
+// CommentsQuery comments list pagination, sort and rating filter query params
+type CommentsQuery struct {
+	Page      int64   `query:"page" validate:"gte=0"`
+	Size      int64   `query:"size" validate:"required,gte=1"`
+	Cursor    string  `query:"cursor"`
+	Sort      string  `query:"sort" validate:"omitempty,oneof=newest oldest highest lowest helpful"`
+	MinRating float64 `query:"min_rating" validate:"gte=0,lte=10"`
+	MaxRating float64 `query:"max_rating" validate:"gte=0,lte=10"`
+}
+
 // CommentFromProto
 func CommentFromProto(comment *commentsService.Comment) (*Comment, error) {
 	commUUID, err := uuid.FromString(comment.CommentID)
@@ -56,15 +103,27 @@ func CommentFromProto(comment *commentsService.Comment) (*Comment, error) {
 		return nil, err
 	}
 
+	var parentUUID *uuid.UUID
+	if comment.GetParentCommentID() != "" {
+		parentID, err := uuid.FromString(comment.GetParentCommentID())
+		if err != nil {
+			return nil, err
+		}
+		parentUUID = &parentID
+	}
+
 	return &Comment{
-		CommentID: commUUID,
-		HotelID:   hotelUUID,
-		UserID:    userUUID,
-		Message:   comment.Message,
-		Photos:    comment.Photos,
-		Rating:    comment.Rating,
-		CreatedAt: &createdAt,
-		UpdatedAt: &updatedAt,
+		CommentID:       commUUID,
+		HotelID:         hotelUUID,
+		UserID:          userUUID,
+		Message:         comment.Message,
+		Photos:          comment.Photos,
+		Rating:          comment.Rating,
+		CreatedAt:       &createdAt,
+		UpdatedAt:       &updatedAt,
+		ParentCommentID: parentUUID,
+		HelpfulCount:    comment.GetHelpfulCount(),
+		Hidden:          comment.GetHidden(),
 	}, nil
 }
 
@@ -78,6 +137,9 @@ type CommentFull struct {
 	Rating    float64      `json:"rating"`
 	CreatedAt *time.Time   `json:"createdAt"`
 	UpdatedAt *time.Time   `json:"updatedAt"`
+
+	HelpfulCount int64          `json:"helpfulCount"`
+	Replies      []*CommentFull `json:"replies,omitempty"`
 }
 
 // CommentFullFromProto
@@ -105,14 +167,25 @@ func CommentFullFromProto(comm *commentsService.CommentFull) (*CommentFull, erro
 	user := &CommentUser{}
 	user.FromProto(comm.GetUser())
 
+	replies := make([]*CommentFull, 0, len(comm.GetReplies()))
+	for _, r := range comm.GetReplies() {
+		reply, err := CommentFullFromProto(r)
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+
 	return &CommentFull{
-		CommentID: commUUID,
-		HotelID:   hotelUUID,
-		User:      user,
-		Message:   comm.GetMessage(),
-		Photos:    comm.GetPhotos(),
-		Rating:    comm.GetRating(),
-		CreatedAt: &createdAt,
-		UpdatedAt: &updatedAt,
+		CommentID:    commUUID,
+		HotelID:      hotelUUID,
+		User:         user,
+		Message:      comm.GetMessage(),
+		Photos:       comm.GetPhotos(),
+		Rating:       comm.GetRating(),
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+		HelpfulCount: comm.GetHelpfulCount(),
+		Replies:      replies,
 	}, nil
 }
@@ -26,6 +26,23 @@ type Hotel struct {
 	Longitude     *float64   `json:"longitude,omitempty"`
 	CreatedAt     *time.Time `json:"created_at"`
 	UpdatedAt     *time.Time `json:"updated_at"`
+	OwnerID       *uuid.UUID `json:"owner_id,omitempty"`
+}
+
+// HotelsQuery hotels list pagination, sort and filter query params
+type HotelsQuery struct {
+	Page      int64   `query:"page" validate:"gte=0"`
+	Size      int64   `query:"size" validate:"required,gte=1"`
+	Cursor    string  `query:"cursor"`
+	SortBy    string  `query:"sort_by" validate:"omitempty,oneof=rating name created_at comments_count distance"`
+	SortOrder string  `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Country   string  `query:"country" validate:"omitempty,max=25"`
+	City      string  `query:"city" validate:"omitempty,max=25"`
+	MinRating float64 `query:"min_rating" validate:"gte=0,lte=10"`
+	MaxRating float64 `query:"max_rating" validate:"gte=0,lte=10"`
+	HasImage  bool    `query:"has_image"`
+	Latitude  float64 `query:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `query:"longitude" validate:"gte=-180,lte=180"`
 }
 
 // HotelsListRes
@@ -35,6 +52,7 @@ type HotelsListRes struct {
 	Page       int64    `json:"page"`
 	Size       int64    `json:"size"`
 	HasMore    bool     `json:"hasMore"`
+	NextCursor string   `json:"nextCursor,omitempty"`
 	Hotels     []*Hotel `json:"hotels"`
 }
 
@@ -54,6 +72,15 @@ func HotelFromProto(v *hotelsService.Hotel) (*Hotel, error) {
 		return nil, err
 	}
 
+	var ownerID *uuid.UUID
+	if v.GetOwnerID() != "" {
+		ownerUUID, err := uuid.FromString(v.GetOwnerID())
+		if err != nil {
+			return nil, err
+		}
+		ownerID = &ownerUUID
+	}
+
 	return &Hotel{
 		HotelID:       hotelUUID,
 		Name:          v.GetName(),
@@ -70,5 +97,6 @@ func HotelFromProto(v *hotelsService.Hotel) (*Hotel, error) {
 		Longitude:     &v.Longitude,
 		CreatedAt:     &createdAt,
 		UpdatedAt:     &updatedAt,
+		OwnerID:       ownerID,
 	}, nil
 }
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	uuid "github.com/satori/go.uuid"
 
 	sessionService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/session"
@@ -9,7 +11,9 @@ import (
 // Session
 type Session struct {
 	UserID    uuid.UUID `json:"user_id"`
-	SessionID string    `json:"session_id"`
+	SessionID string    `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Renewed   bool      `json:"-"`
 }
 
 // FromProto
@@ -20,5 +24,6 @@ func (s *Session) FromProto(session *sessionService.Session) (*Session, error) {
 	}
 	s.UserID = userUUID
 	s.SessionID = session.GetSessionID()
+	s.ExpiresAt = session.GetExpiresAt().AsTime()
 	return s, nil
 }
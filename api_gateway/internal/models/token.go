@@ -0,0 +1,12 @@
+package models
+
+import (
+	"github.com/dgrijalva/jwt-go"
+)
+
+// AccessTokenClaims of access token issued by user service, subject is user id
+type AccessTokenClaims struct {
+	Role     string `json:"role"`
+	FamilyID string `json:"fid"`
+	jwt.StandardClaims
+}
@@ -10,6 +10,9 @@ import (
 	userService "github.com/AleksK1NG/hotels-mocroservices/api-gateway/proto/user"
 )
 
+// RoleAdmin role of user service administrators
+const RoleAdmin = "admin"
+
 // User
 type UserResponse struct {
 	UserID    uuid.UUID  `json:"user_id"`
@@ -20,6 +23,59 @@ type UserResponse struct {
 	Avatar    *string    `json:"avatar" validate:"max=250" swaggertype:"string"`
 	CreatedAt *time.Time `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at"`
+
+	EmailVerifiedAt    *time.Time `json:"email_verified_at"`
+	TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at"`
+	SuspendedAt        *time.Time `json:"suspended_at"`
+
+	Permissions []userService.Permission `json:"-"`
+}
+
+// IsEmailVerified
+func (u *UserResponse) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsTwoFactorEnabled
+func (u *UserResponse) IsTwoFactorEnabled() bool {
+	return u.TwoFactorEnabledAt != nil
+}
+
+// IsSuspended
+func (u *UserResponse) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// IsAdmin
+func (u *UserResponse) IsAdmin() bool {
+	return u.Role != nil && *u.Role == RoleAdmin
+}
+
+// GetRole user role or empty string
+func (u *UserResponse) GetRole() string {
+	if u.Role == nil {
+		return ""
+	}
+	return *u.Role
+}
+
+// HasPermission check user service granted user all given permissions, roles are mapped to permissions by user service only
+func (u *UserResponse) HasPermission(permissions ...userService.Permission) bool {
+	for _, permission := range permissions {
+		if !u.hasPermission(permission) {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *UserResponse) hasPermission(permission userService.Permission) bool {
+	for _, granted := range u.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
 }
 
 // UserFromProtoRes
@@ -39,7 +95,7 @@ func UserFromProtoRes(user *userService.User) (*UserResponse, error) {
 		return nil, err
 	}
 
-	return &UserResponse{
+	res := &UserResponse{
 		UserID:    userUUID,
 		FirstName: user.GetFirstName(),
 		LastName:  user.GetLastName(),
@@ -48,25 +104,40 @@ func UserFromProtoRes(user *userService.User) (*UserResponse, error) {
 		Avatar:    &user.Avatar,
 		CreatedAt: &createdAt,
 		UpdatedAt: &updatedAt,
-	}, nil
+
+		Permissions: user.GetPermissions(),
+	}
+	if user.GetEmailVerifiedAt() != nil {
+		emailVerifiedAt := user.GetEmailVerifiedAt().AsTime()
+		res.EmailVerifiedAt = &emailVerifiedAt
+	}
+	if user.GetTwoFactorEnabledAt() != nil {
+		twoFactorEnabledAt := user.GetTwoFactorEnabledAt().AsTime()
+		res.TwoFactorEnabledAt = &twoFactorEnabledAt
+	}
+	if user.GetSuspendedAt() != nil {
+		suspendedAt := user.GetSuspendedAt().AsTime()
+		res.SuspendedAt = &suspendedAt
+	}
+
+	return res, nil
 }
 
-// UserProtoRes
+// CommentUser comment author public profile
 type CommentUser struct {
-	UserID    string `json:"userId"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
-	Avatar    string `json:"avatar"`
-	Role      string `json:"role"`
+	UserID      string     `json:"userId"`
+	DisplayName string     `json:"displayName"`
+	Avatar      string     `json:"avatar"`
+	MemberSince *time.Time `json:"memberSince,omitempty"`
 }
 
 // FromProto
 func (u *CommentUser) FromProto(user *commentsService.User) {
-	u.UserID = user.UserID
-	u.FirstName = user.FirstName
-	u.LastName = user.LastName
-	u.Email = user.Email
-	u.Avatar = user.Avatar
-	u.Role = user.Role
+	u.UserID = user.GetUserID()
+	u.DisplayName = user.GetDisplayName()
+	u.Avatar = user.GetAvatar()
+	if user.GetMemberSince() != nil {
+		memberSince := user.GetMemberSince().AsTime()
+		u.MemberSince = &memberSince
+	}
 }
@@ -128,7 +128,7 @@ func (s *server) Run() error {
 		}
 	}()
 
-	v1 := s.echo.Group("/api/v1")
+	v1 := s.echo.Group("/api/v1", mw.CSRFMiddleware)
 	hotelsGroup := v1.Group("/hotels")
 	commentsGroup := v1.Group("/comments")
 
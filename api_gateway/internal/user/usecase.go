@@ -12,4 +12,5 @@ import (
 type UseCase interface {
 	GetByID(ctx context.Context, userUUID uuid.UUID) (*models.UserResponse, error)
 	GetSessionByID(ctx context.Context, sessionID string) (*models.Session, error)
+	CheckCSRFToken(ctx context.Context, sessionID string, token string) (bool, error)
 }
@@ -62,6 +62,23 @@ func (u *userUseCase) GetSessionByID(ctx context.Context, sessionID string) (*mo
 	if err != nil {
 		return nil, errors.Wrap(err, "sess.FromProto")
 	}
+	sess.Renewed = sessionByID.GetRenewed()
 
 	return sess, nil
 }
+
+// CheckCSRFToken validate csrf token of session
+func (u *userUseCase) CheckCSRFToken(ctx context.Context, sessionID string, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.CheckCSRFToken")
+	defer span.Finish()
+
+	res, err := u.sessClient.CheckCsrfToken(
+		ctx,
+		&sessionService.CheckCsrfTokenRequest{CsrfTokenCheck: &sessionService.CsrfTokenCheck{SessionID: sessionID, Token: token}},
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "sessClient.CheckCsrfToken")
+	}
+
+	return res.GetCheckResult().GetResult(), nil
+}
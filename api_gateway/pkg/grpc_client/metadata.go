@@ -0,0 +1,17 @@
+package grpc_client
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// UserIDMetadataKey gRPC metadata key with id of the user who made the request
+	UserIDMetadataKey = "user_id"
+)
+
+// WithUserID add request user id to outgoing gRPC metadata, backend services load user role by id
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, UserIDMetadataKey, userID)
+}
@@ -10,6 +10,9 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	pkgErrors "github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -47,6 +50,10 @@ var (
 	NotAllowedImageHeader = errors.New("Not allowed image header")
 	NoCookie              = errors.New("not found cookie header")
 	InvalidUUID           = errors.New("invalid uuid")
+	EmailNotVerified      = errors.New("Email is not verified")
+	AccountSuspended      = errors.New("Account suspended")
+
+	TwoFactorEnrollmentRequired = errors.New("Two-factor authentication enrollment required")
 )
 
 // Rest error interface
@@ -159,6 +166,10 @@ func NewInternalServerError(causes interface{}) RestErr {
 
 // Parser of error string messages returns RestError
 func ParseErrors(err error) RestErr {
+	if restErr, ok := parseGRPCStatus(err); ok {
+		return restErr
+	}
+
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return NewRestError(http.StatusNotFound, ErrNotFound, nil)
@@ -168,6 +179,12 @@ func ParseErrors(err error) RestErr {
 		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, nil)
 	case errors.Is(err, WrongCredentials):
 		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, nil)
+	case errors.Is(err, WrongCSRFToken), errors.Is(err, CSRFNotPresented), errors.Is(err, ExpiredCSRFError):
+		return NewRestError(http.StatusForbidden, ErrForbidden, err.Error())
+	case errors.Is(err, EmailNotVerified), errors.Is(err, TwoFactorEnrollmentRequired), errors.Is(err, AccountSuspended):
+		return NewRestError(http.StatusForbidden, ErrForbidden, err.Error())
+	case errors.Is(err, Forbidden), errors.Is(err, PermissionDenied):
+		return NewRestError(http.StatusForbidden, ErrForbidden, nil)
 	case strings.Contains(strings.ToLower(err.Error()), "sqlstate"):
 		return parseSqlErrors(err)
 	case strings.Contains(strings.ToLower(err.Error()), "field validation"):
@@ -190,6 +207,31 @@ func ParseErrors(err error) RestErr {
 	}
 }
 
+// parseGRPCStatus map backend gRPC status codes to rest errors
+func parseGRPCStatus(err error) (RestErr, bool) {
+	st, ok := status.FromError(pkgErrors.Cause(err))
+	if !ok {
+		return nil, false
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return NewRestError(http.StatusNotFound, ErrNotFound, st.Message()), true
+	case codes.InvalidArgument:
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, st.Message()), true
+	case codes.AlreadyExists:
+		return NewRestError(http.StatusConflict, ErrAlreadyExists, st.Message()), true
+	case codes.PermissionDenied:
+		return NewRestError(http.StatusForbidden, ErrForbidden, st.Message()), true
+	case codes.Unauthenticated:
+		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, st.Message()), true
+	case codes.DeadlineExceeded:
+		return NewRestError(http.StatusRequestTimeout, ErrRequestTimeout, nil), true
+	}
+
+	return nil, false
+}
+
 func parseSqlErrors(err error) RestErr {
 	return NewRestError(http.StatusBadRequest, ErrBadRequest, err)
 }
@@ -17,6 +17,9 @@ type Config struct {
 	Logger     Logger
 	Jaeger     Jaeger
 	RabbitMQ   RabbitMQ
+	Photos     Photos
+	Moderation Moderation
+	UsersCache UsersCache
 }
 
 type HttpServer struct {
@@ -47,6 +50,7 @@ type GRPCServer struct {
 	MaxConnectionAge       time.Duration
 	SessionGrpcServicePort string
 	UserGrpcServicePort    string
+	HotelsGrpcServicePort  string
 }
 
 // RabbitMQ
@@ -60,6 +64,36 @@ type RabbitMQ struct {
 	RoutingKey     string
 	ConsumerTag    string
 	WorkerPoolSize int
+	DrainTimeout   time.Duration
+
+	UpdatePhotosConsumer RabbitMQConsumer
+	UserUpdatedConsumer  RabbitMQConsumer
+}
+
+// RabbitMQConsumer worker pool and prefetch config of single queue consumer
+type RabbitMQConsumer struct {
+	WorkerPoolSize int
+	PrefetchCount  int
+}
+
+// Photos comment photos config, only urls from images bucket are accepted
+type Photos struct {
+	BucketURL string
+	MaxCount  int
+}
+
+// Moderation comments abuse reports config, comment is hidden pending admin review when reports reach threshold
+type Moderation struct {
+	ReportsThreshold int
+}
+
+// UsersCache comment authors cache, in-process LRU optionally backed by redis
+type UsersCache struct {
+	Size         int
+	TTL          time.Duration
+	RedisEnabled bool
+	RedisTTL     time.Duration
+	RedisPrefix  string
 }
 
 // Logger config
@@ -16,6 +16,12 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/comments/proto/comments"
 )
 
+const (
+	// replyMessageRules same message rules as comment, replies have no rating
+	replyMessageRules = "required,min=5,max=500"
+	reportReasonRules = "required,min=3,max=250"
+)
+
 // CommentsService
 type CommentsService struct {
 	commUC   comment.UseCase
@@ -83,7 +89,8 @@ func (c *CommentsService) UpdateComment(ctx context.Context, req *commentsServic
 
 	commUUID, err := uuid.FromString(req.GetCommentID())
 	if err != nil {
-		return nil, err
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
 	}
 
 	comm := &models.Comment{
@@ -107,6 +114,189 @@ func (c *CommentsService) UpdateComment(ctx context.Context, req *commentsServic
 	return &commentsService.UpdateCommRes{Comment: updatedComm.ToProto()}, nil
 }
 
+// CreateReply
+func (c *CommentsService) CreateReply(ctx context.Context, req *commentsService.CreateReplyReq) (*commentsService.CreateReplyRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.CreateReply")
+	defer span.Finish()
+
+	parentUUID, err := uuid.FromString(req.GetParentCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.validate.VarCtx(ctx, req.GetMessage(), replyMessageRules); err != nil {
+		c.logger.Errorf("validate.VarCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	reply, err := c.commUC.CreateReply(ctx, parentUUID, req.GetMessage())
+	if err != nil {
+		c.logger.Errorf("commUC.CreateReply: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.CreateReplyRes{Comment: reply.ToProto()}, nil
+}
+
+// UpdateReply
+func (c *CommentsService) UpdateReply(ctx context.Context, req *commentsService.UpdateReplyReq) (*commentsService.UpdateReplyRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.UpdateReply")
+	defer span.Finish()
+
+	replyUUID, err := uuid.FromString(req.GetCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.validate.VarCtx(ctx, req.GetMessage(), replyMessageRules); err != nil {
+		c.logger.Errorf("validate.VarCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	reply, err := c.commUC.UpdateReply(ctx, replyUUID, req.GetMessage())
+	if err != nil {
+		c.logger.Errorf("commUC.UpdateReply: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.UpdateReplyRes{Comment: reply.ToProto()}, nil
+}
+
+// VoteHelpful
+func (c *CommentsService) VoteHelpful(ctx context.Context, req *commentsService.VoteHelpfulReq) (*commentsService.VoteHelpfulRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.VoteHelpful")
+	defer span.Finish()
+
+	commUUID, err := uuid.FromString(req.GetCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	helpfulCount, err := c.commUC.VoteHelpful(ctx, commUUID)
+	if err != nil {
+		c.logger.Errorf("commUC.VoteHelpful: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.VoteHelpfulRes{CommentID: commUUID.String(), HelpfulCount: int64(helpfulCount)}, nil
+}
+
+// ReportComment
+func (c *CommentsService) ReportComment(ctx context.Context, req *commentsService.ReportCommentReq) (*commentsService.ReportCommentRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.ReportComment")
+	defer span.Finish()
+
+	commUUID, err := uuid.FromString(req.GetCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.validate.VarCtx(ctx, req.GetReason(), reportReasonRules); err != nil {
+		c.logger.Errorf("validate.VarCtx: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.commUC.Report(ctx, commUUID, req.GetReason()); err != nil {
+		c.logger.Errorf("commUC.Report: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.ReportCommentRes{CommentID: commUUID.String()}, nil
+}
+
+// GetHidden
+func (c *CommentsService) GetHidden(ctx context.Context, req *commentsService.GetHiddenReq) (*commentsService.GetHiddenRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.GetHidden")
+	defer span.Finish()
+
+	query := utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	if err := query.SetCursor(req.GetCursor()); err != nil {
+		c.logger.Errorf("query.SetCursor: %v", err)
+		return nil, grpcErrors.ErrorResponse(grpcErrors.ErrInvalidCursor, err.Error())
+	}
+
+	commentsList, err := c.commUC.GetHidden(ctx, query)
+	if err != nil {
+		c.logger.Errorf("commUC.GetHidden: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.GetHiddenRes{
+		TotalCount: int64(commentsList.TotalCount),
+		TotalPages: int64(commentsList.TotalPages),
+		Page:       int64(commentsList.Page),
+		Size:       int64(commentsList.Size),
+		HasMore:    commentsList.HasMore,
+		Comments:   commentsList.ToProto(),
+		NextCursor: commentsList.NextCursor,
+	}, nil
+}
+
+// ReviewComment
+func (c *CommentsService) ReviewComment(ctx context.Context, req *commentsService.ReviewCommentReq) (*commentsService.ReviewCommentRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.ReviewComment")
+	defer span.Finish()
+
+	commUUID, err := uuid.FromString(req.GetCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.commUC.Review(ctx, commUUID, req.GetApprove()); err != nil {
+		c.logger.Errorf("commUC.Review: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.ReviewCommentRes{}, nil
+}
+
+// DeleteComment
+func (c *CommentsService) DeleteComment(ctx context.Context, req *commentsService.DeleteCommentReq) (*commentsService.DeleteCommentRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.DeleteComment")
+	defer span.Finish()
+
+	commUUID, err := uuid.FromString(req.GetCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.commUC.Delete(ctx, commUUID); err != nil {
+		c.logger.Errorf("commUC.Delete: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.DeleteCommentRes{}, nil
+}
+
+// UploadPhoto
+func (c *CommentsService) UploadPhoto(ctx context.Context, req *commentsService.UploadPhotoReq) (*commentsService.UploadPhotoRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.UploadPhoto")
+	defer span.Finish()
+
+	commUUID, err := uuid.FromString(req.GetCommentID())
+	if err != nil {
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	if err := c.commUC.UploadPhoto(ctx, &models.UploadCommentPhotoMsg{
+		CommentID:   commUUID,
+		Data:        req.GetData(),
+		ContentType: req.GetContentType(),
+	}); err != nil {
+		c.logger.Errorf("commUC.UploadPhoto: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.UploadPhotoRes{CommentID: commUUID.String()}, nil
+}
+
 // GetByHotelID
 func (c *CommentsService) GetByHotelID(ctx context.Context, req *commentsService.GetByHotelReq) (*commentsService.GetByHotelRes, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.GetByHotelID")
@@ -119,8 +309,14 @@ func (c *CommentsService) GetByHotelID(ctx context.Context, req *commentsService
 	}
 
 	query := utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	if err := query.SetCursor(req.GetCursor()); err != nil {
+		c.logger.Errorf("query.SetCursor: %v", err)
+		return nil, grpcErrors.ErrorResponse(grpcErrors.ErrInvalidCursor, err.Error())
+	}
+	query.SetOrderBy(req.GetSort())
 
-	commentsList, err := c.commUC.GetByHotelID(ctx, hotelUUID, query)
+	filter := &models.CommentsFilter{MinRating: req.GetMinRating(), MaxRating: req.GetMaxRating()}
+	commentsList, err := c.commUC.GetByHotelID(ctx, hotelUUID, query, filter)
 	if err != nil {
 		c.logger.Errorf("commUC.GetByHotelID: %v", err)
 		return nil, grpcErrors.ErrorResponse(err, err.Error())
@@ -133,22 +329,53 @@ func (c *CommentsService) GetByHotelID(ctx context.Context, req *commentsService
 		Size:       int64(commentsList.Size),
 		HasMore:    commentsList.HasMore,
 		Comments:   commentsList.Comments,
+		NextCursor: commentsList.NextCursor,
 	}, nil
 }
 
-func (c *CommentsService) protoToModel(req *commentsService.CreateCommentReq) (*models.Comment, error) {
-	hotelUUID, err := uuid.FromString(req.GetHotelID())
+// GetByUserID
+func (c *CommentsService) GetByUserID(ctx context.Context, req *commentsService.GetByUserReq) (*commentsService.GetByUserRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommentsService.GetByUserID")
+	defer span.Finish()
+
+	userUUID, err := uuid.FromString(req.GetUserID())
 	if err != nil {
-		return nil, err
+		c.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
 	}
-	userUUID, err := uuid.FromString(req.GetUserID())
+
+	query := utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	if err := query.SetCursor(req.GetCursor()); err != nil {
+		c.logger.Errorf("query.SetCursor: %v", err)
+		return nil, grpcErrors.ErrorResponse(grpcErrors.ErrInvalidCursor, err.Error())
+	}
+	query.SetOrderBy(req.GetSort())
+
+	commentsList, err := c.commUC.GetByUserID(ctx, userUUID, query)
+	if err != nil {
+		c.logger.Errorf("commUC.GetByUserID: %v", err)
+		return nil, grpcErrors.ErrorResponse(err, err.Error())
+	}
+
+	return &commentsService.GetByUserRes{
+		TotalCount: int64(commentsList.TotalCount),
+		TotalPages: int64(commentsList.TotalPages),
+		Page:       int64(commentsList.Page),
+		Size:       int64(commentsList.Size),
+		HasMore:    commentsList.HasMore,
+		Comments:   commentsList.ToProto(),
+		NextCursor: commentsList.NextCursor,
+	}, nil
+}
+
+func (c *CommentsService) protoToModel(req *commentsService.CreateCommentReq) (*models.Comment, error) {
+	hotelUUID, err := uuid.FromString(req.GetHotelID())
 	if err != nil {
 		return nil, err
 	}
 
 	return &models.Comment{
 		HotelID: hotelUUID,
-		UserID:  userUUID,
 		Message: req.GetMessage(),
 		Photos:  req.GetPhotos(),
 		Rating:  req.GetRating(),
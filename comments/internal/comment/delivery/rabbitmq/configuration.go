@@ -0,0 +1,107 @@
+package rabbitmq
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/streadway/amqp"
+)
+
+const (
+	exchangeKind       = "direct"
+	exchangeDurable    = true
+	exchangeAutoDelete = false
+	exchangeInternal   = false
+	exchangeNoWait     = false
+
+	queueDurable    = true
+	queueAutoDelete = false
+	queueExclusive  = false
+	queueNoWait     = false
+
+	instanceQueueDurable    = false
+	instanceQueueAutoDelete = true
+	instanceQueueExclusive  = true
+
+	publishMandatory = false
+	publishImmediate = false
+
+	prefetchSize   = 0
+	prefetchGlobal = false
+
+	consumeAutoAck   = false
+	consumeExclusive = false
+	consumeNoLocal   = false
+	consumeNoWait    = false
+
+	CommentsExchange = "comments"
+
+	deadLetterExchange    = "comments_dead_letter"
+	deadLetterQueueSuffix = "_dlq"
+
+	UpdatePhotosQueue       = "update_comment_photos"
+	UpdatePhotosBindingKey  = "update_comment_photos_key"
+	UpdatePhotosConsumerTag = "update_comment_photos_consumer"
+
+	UsersExchange = "users"
+	// UserUpdatedQueue is not declared, every instance binds own server named queue so each replica cache sees every invalidation,
+	// name is used as dead letter routing key and metrics label
+	UserUpdatedQueue       = "comments_user_updated"
+	UserUpdatedBindingKey  = "user_updated_key"
+	UserUpdatedConsumerTag = "comments_user_updated_consumer"
+)
+
+var (
+	incomingMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rabbitmq_comments_incoming_messages_total",
+		Help: "The total number of incoming RabbitMQ messages",
+	})
+	successMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rabbitmq_comments_success_messages_total",
+		Help: "The total number of success incoming success RabbitMQ messages",
+	})
+	errorMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rabbitmq_comments_error_messages_total",
+		Help: "The total number of error incoming success RabbitMQ messages",
+	})
+	busyWorkers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rabbitmq_comments_busy_workers",
+		Help: "The number of workers processing RabbitMQ delivery per queue",
+	}, []string{"queue"})
+)
+
+// Initialize consumers
+func (c *commentsConsumer) Initialize() error {
+	if err := c.Dial(); err != nil {
+		return errors.Wrap(err, "Consumer Dial")
+	}
+
+	updatePhotosChan, err := c.CreateExchangeAndQueue(CommentsExchange, UpdatePhotosQueue, UpdatePhotosBindingKey)
+	if err != nil {
+		return errors.Wrap(err, "CreateExchangeAndQueue")
+	}
+
+	userUpdatedChan, userUpdatedQueue, err := c.CreateExchangeAndInstanceQueue(UsersExchange, UserUpdatedQueue, UserUpdatedBindingKey)
+	if err != nil {
+		return errors.Wrap(err, "CreateExchangeAndInstanceQueue")
+	}
+	c.userUpdatedQueue = userUpdatedQueue
+
+	c.channels = append(c.channels, updatePhotosChan, userUpdatedChan)
+
+	return nil
+}
+
+// CloseChannels close active channels
+func (c *commentsConsumer) CloseChannels() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, channel := range c.channels {
+		go func(ch *amqp.Channel) {
+			if err := ch.Close(); err != nil {
+				c.logger.Errorf("CloseChannels ch.Close error: %v", err)
+			}
+		}(channel)
+	}
+}
@@ -0,0 +1,351 @@
+package rabbitmq
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/streadway/amqp"
+
+	"github.com/AleksK1NG/hotels-mocroservices/comments/config"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/user"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/rabbitmq"
+)
+
+// Consumer
+type Consumer struct {
+	Worker         func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery)
+	WorkerPoolSize int
+	PrefetchCount  int
+	QueueName      string
+	ConsumerTag    string
+}
+
+// commentsConsumer
+type commentsConsumer struct {
+	amqpConn   *amqp.Connection
+	logger     logger.Logger
+	cfg        *config.Config
+	commUC     comment.UseCase
+	usersCache user.UsersCache
+	consumers  []*Consumer
+	channels   []*amqp.Channel
+
+	userUpdatedQueue string
+
+	mu              sync.Mutex
+	consumeChannels map[string]*amqp.Channel
+	workers         sync.WaitGroup
+}
+
+// NewCommentsConsumer
+func NewCommentsConsumer(logger logger.Logger, cfg *config.Config, commUC comment.UseCase, usersCache user.UsersCache) *commentsConsumer {
+	return &commentsConsumer{
+		logger:          logger,
+		cfg:             cfg,
+		commUC:          commUC,
+		usersCache:      usersCache,
+		consumeChannels: make(map[string]*amqp.Channel),
+	}
+}
+
+// Dial
+func (c *commentsConsumer) Dial() error {
+	conn, err := rabbitmq.NewRabbitMQConn(c.cfg)
+	if err != nil {
+		return err
+	}
+	c.amqpConn = conn
+	return nil
+}
+
+// Consume messages
+func (c *commentsConsumer) CreateExchangeAndQueue(exchangeName, queueName, bindingKey string) (*amqp.Channel, error) {
+	ch, err := c.amqpConn.Channel()
+	if err != nil {
+		return nil, errors.Wrap(err, "Error amqpConn.Channel")
+	}
+
+	c.logger.Infof("Declaring exchange: %s", exchangeName)
+	err = ch.ExchangeDeclare(
+		exchangeName,
+		exchangeKind,
+		exchangeDurable,
+		exchangeAutoDelete,
+		exchangeInternal,
+		exchangeNoWait,
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error ch.ExchangeDeclare")
+	}
+
+	if err := c.declareDeadLetterQueue(ch, queueName); err != nil {
+		return nil, errors.Wrap(err, "declareDeadLetterQueue")
+	}
+
+	// rejected deliveries (invalid payload, unknown event version) are routed to queueName_dlq by broker policy
+	// (make rabbitmq_policies), x-dead-letter arguments would fail with PRECONDITION_FAILED on already declared queues
+	queue, err := ch.QueueDeclare(
+		queueName,
+		queueDurable,
+		queueAutoDelete,
+		queueExclusive,
+		queueNoWait,
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error ch.QueueDeclare")
+	}
+
+	c.logger.Infof("Declared queue, binding it to exchange: Queue: %v, messagesCount: %v, "+
+		"consumerCount: %v, exchange: %v, bindingKey: %v",
+		queue.Name,
+		queue.Messages,
+		queue.Consumers,
+		exchangeName,
+		bindingKey,
+	)
+
+	err = ch.QueueBind(
+		queue.Name,
+		bindingKey,
+		exchangeName,
+		queueNoWait,
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error ch.QueueBind")
+	}
+
+	return ch, nil
+}
+
+// CreateExchangeAndInstanceQueue declare exchange and exclusive auto delete server named queue bound to it,
+// queue lives as long as this instance connection, returns declared queue name
+func (c *commentsConsumer) CreateExchangeAndInstanceQueue(exchangeName, deadLetterKey, bindingKey string) (*amqp.Channel, string, error) {
+	ch, err := c.amqpConn.Channel()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Error amqpConn.Channel")
+	}
+
+	c.logger.Infof("Declaring exchange: %s", exchangeName)
+	err = ch.ExchangeDeclare(
+		exchangeName,
+		exchangeKind,
+		exchangeDurable,
+		exchangeAutoDelete,
+		exchangeInternal,
+		exchangeNoWait,
+		nil,
+	)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Error ch.ExchangeDeclare")
+	}
+
+	if err := c.declareDeadLetterQueue(ch, deadLetterKey); err != nil {
+		return nil, "", errors.Wrap(err, "declareDeadLetterQueue")
+	}
+
+	queue, err := ch.QueueDeclare(
+		"",
+		instanceQueueDurable,
+		instanceQueueAutoDelete,
+		instanceQueueExclusive,
+		queueNoWait,
+		amqp.Table{
+			"x-dead-letter-exchange":    deadLetterExchange,
+			"x-dead-letter-routing-key": deadLetterKey,
+		},
+	)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Error ch.QueueDeclare")
+	}
+
+	c.logger.Infof("Declared instance queue, binding it to exchange: Queue: %v, exchange: %v, bindingKey: %v",
+		queue.Name,
+		exchangeName,
+		bindingKey,
+	)
+
+	err = ch.QueueBind(
+		queue.Name,
+		bindingKey,
+		exchangeName,
+		queueNoWait,
+		nil,
+	)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Error ch.QueueBind")
+	}
+
+	return ch, queue.Name, nil
+}
+
+// declareDeadLetterQueue declare dead letter exchange and queue for rejected deliveries of queueName
+func (c *commentsConsumer) declareDeadLetterQueue(ch *amqp.Channel, queueName string) error {
+	c.logger.Infof("Declaring dead letter exchange: %s", deadLetterExchange)
+	if err := ch.ExchangeDeclare(
+		deadLetterExchange,
+		exchangeKind,
+		exchangeDurable,
+		exchangeAutoDelete,
+		exchangeInternal,
+		exchangeNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.ExchangeDeclare")
+	}
+
+	dlq, err := ch.QueueDeclare(
+		queueName+deadLetterQueueSuffix,
+		queueDurable,
+		queueAutoDelete,
+		queueExclusive,
+		queueNoWait,
+		nil,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Error ch.QueueDeclare")
+	}
+
+	if err := ch.QueueBind(
+		dlq.Name,
+		queueName,
+		deadLetterExchange,
+		queueNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.QueueBind")
+	}
+
+	return nil
+}
+
+func (c *commentsConsumer) startConsume(
+	ctx context.Context,
+	worker func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery),
+	workerPoolSize int,
+	prefetchCount int,
+	queueName string,
+	consumerTag string,
+) error {
+	ch, err := c.amqpConn.Channel()
+	if err != nil {
+		return errors.Wrap(err, "c.amqpConn.Channel")
+	}
+
+	err = ch.Qos(
+		prefetchCount,  // prefetch count
+		prefetchSize,   // prefetch size
+		prefetchGlobal, // global
+	)
+	if err != nil {
+		return errors.Wrap(err, "Error  ch.Qos")
+	}
+
+	deliveries, err := ch.Consume(
+		queueName,
+		consumerTag,
+		consumeAutoAck,
+		consumeExclusive,
+		consumeNoLocal,
+		consumeNoWait,
+		nil,
+	)
+	if err != nil {
+		return errors.Wrap(err, "ch.Consume")
+	}
+
+	c.mu.Lock()
+	c.channels = append(c.channels, ch)
+	c.consumeChannels[consumerTag] = ch
+	c.mu.Unlock()
+
+	wg := &sync.WaitGroup{}
+
+	wg.Add(workerPoolSize)
+	for i := 0; i < workerPoolSize; i++ {
+		go worker(ctx, wg, deliveries)
+	}
+
+	c.workers.Add(1)
+	go func() {
+		defer c.workers.Done()
+		wg.Wait()
+	}()
+
+	chanErr := <-ch.NotifyClose(make(chan *amqp.Error))
+	c.logger.Errorf("ch.NotifyClose: %v", chanErr)
+
+	wg.Wait()
+
+	return chanErr
+}
+
+func (c *commentsConsumer) AddConsumer(consumer *Consumer) {
+	c.consumers = append(c.consumers, consumer)
+}
+
+func (c *commentsConsumer) run(ctx context.Context, cancel context.CancelFunc) {
+	for _, cs := range c.consumers {
+		go func(consumer *Consumer) {
+			if err := c.startConsume(
+				ctx,
+				consumer.Worker,
+				consumer.WorkerPoolSize,
+				consumer.PrefetchCount,
+				consumer.QueueName,
+				consumer.ConsumerTag,
+			); err != nil {
+				c.logger.Errorf("StartResizeConsumer: %v", err)
+				cancel()
+			}
+		}(cs)
+	}
+}
+
+func (c *commentsConsumer) RunConsumers(ctx context.Context, cancel context.CancelFunc) {
+	c.AddConsumer(&Consumer{
+		Worker:         c.updatePhotosWorker,
+		WorkerPoolSize: c.cfg.RabbitMQ.UpdatePhotosConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.UpdatePhotosConsumer.PrefetchCount,
+		QueueName:      UpdatePhotosQueue,
+		ConsumerTag:    UpdatePhotosConsumerTag,
+	})
+	c.AddConsumer(&Consumer{
+		Worker:         c.userUpdatedWorker,
+		WorkerPoolSize: c.cfg.RabbitMQ.UserUpdatedConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.UserUpdatedConsumer.PrefetchCount,
+		QueueName:      c.userUpdatedQueue,
+		ConsumerTag:    UserUpdatedConsumerTag,
+	})
+	c.run(ctx, cancel)
+}
+
+// Drain cancel consumers and wait until workers process and ack in-flight deliveries, must be called before CloseChannels
+func (c *commentsConsumer) Drain(timeout time.Duration) {
+	c.mu.Lock()
+	for consumerTag, ch := range c.consumeChannels {
+		if err := ch.Cancel(consumerTag, consumeNoWait); err != nil {
+			c.logger.Errorf("Drain ch.Cancel: %v", err)
+		}
+	}
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("Consumers drained")
+	case <-time.After(timeout):
+		c.logger.Errorf("Consumers drain timeout: %v", timeout)
+	}
+}
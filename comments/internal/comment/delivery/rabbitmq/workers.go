@@ -0,0 +1,62 @@
+package rabbitmq
+
+import (
+	"context"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/streadway/amqp"
+)
+
+func (c *commentsConsumer) updatePhotosWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
+	defer wg.Done()
+	for delivery := range messages {
+		c.processDelivery(ctx, "commentsConsumer.updatePhotosWorker", UpdatePhotosQueue, delivery, c.commUC.AddPhoto)
+	}
+
+	c.logger.Info("Deliveries channel closed")
+}
+
+func (c *commentsConsumer) userUpdatedWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
+	defer wg.Done()
+	for delivery := range messages {
+		c.processDelivery(ctx, "commentsConsumer.userUpdatedWorker", UserUpdatedQueue, delivery, c.usersCache.InvalidateUser)
+	}
+
+	c.logger.Info("Deliveries channel closed")
+}
+
+// processDelivery ack processed delivery or reject it to dead letter queue,
+// span and busy workers gauge are finished once whatever the outcome is
+func (c *commentsConsumer) processDelivery(
+	ctx context.Context,
+	operationName string,
+	queueName string,
+	delivery amqp.Delivery,
+	process func(ctx context.Context, delivery amqp.Delivery) error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, operationName)
+	defer span.Finish()
+
+	c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
+
+	incomingMessages.Inc()
+	busyWorkers.WithLabelValues(queueName).Inc()
+	defer busyWorkers.WithLabelValues(queueName).Dec()
+
+	if err := process(ctx, delivery); err != nil {
+		if err := delivery.Reject(false); err != nil {
+			c.logger.Errorf("Err delivery.Reject: %v", err)
+		}
+		c.logger.Errorf("Failed to process delivery: %v", err)
+		errorMessages.Inc()
+		return
+	}
+
+	if err := delivery.Ack(false); err != nil {
+		c.logger.Errorf("Failed to acknowledge delivery: %v", err)
+		errorMessages.Inc()
+		return
+	}
+	successMessages.Inc()
+}
@@ -14,5 +14,16 @@ type PGRepository interface {
 	Create(ctx context.Context, comment *models.Comment) (*models.Comment, error)
 	GetByID(ctx context.Context, commentID uuid.UUID) (*models.Comment, error)
 	Update(ctx context.Context, comment *models.Comment) (*models.Comment, error)
-	GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *utils.Pagination) (*models.CommentsList, error)
+	Delete(ctx context.Context, commentID uuid.UUID) error
+	CreateReply(ctx context.Context, reply *models.Comment) (*models.Comment, error)
+	UpdateReply(ctx context.Context, reply *models.Comment) (*models.Comment, error)
+	GetReplies(ctx context.Context, parentIDs []uuid.UUID) ([]*models.Comment, error)
+	AddPhoto(ctx context.Context, commentID uuid.UUID, photoURL string, maxPhotos int) error
+	ExistsByHotelAndUser(ctx context.Context, hotelID uuid.UUID, userID uuid.UUID) (bool, error)
+	GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *utils.Pagination, filter *models.CommentsFilter) (*models.CommentsList, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, query *utils.Pagination) (*models.CommentsList, error)
+	GetHidden(ctx context.Context, query *utils.Pagination) (*models.CommentsList, error)
+	VoteHelpful(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) (int, error)
+	Report(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, reason string, threshold int) (bool, error)
+	Restore(ctx context.Context, commentID uuid.UUID) error
 }
@@ -2,13 +2,18 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/comments_errors"
+	grpcErrors "github.com/AleksK1NG/hotels-mocroservices/comments/pkg/grpc_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/utils"
 )
 
@@ -22,7 +27,8 @@ func NewCommPGRepo(db *pgxpool.Pool) *commPGRepo {
 	return &commPGRepo{db: db}
 }
 
-// Create
+// Create top level review, one review per user per hotel is enforced by unique index,
+// there is no booking service so reviews can't be limited per completed stay
 func (c *commPGRepo) Create(ctx context.Context, comment *models.Comment) (*models.Comment, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.Create")
 	defer span.Finish()
@@ -45,7 +51,13 @@ func (c *commPGRepo) Create(ctx context.Context, comment *models.Comment) (*mode
 		&comm.Rating,
 		&comm.CreatedAt,
 		&comm.UpdatedAt,
+		&comm.ParentCommentID,
+		&comm.HelpfulCount,
+		&comm.Hidden,
 	); err != nil {
+		if strings.Contains(err.Error(), uniqueViolationCode) {
+			return nil, grpcErrors.ErrCommentExists
+		}
 		return nil, errors.Wrap(err, "Scan")
 	}
 
@@ -67,6 +79,9 @@ func (c *commPGRepo) GetByID(ctx context.Context, commentID uuid.UUID) (*models.
 		&comm.Rating,
 		&comm.CreatedAt,
 		&comm.UpdatedAt,
+		&comm.ParentCommentID,
+		&comm.HelpfulCount,
+		&comm.Hidden,
 	); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
@@ -89,20 +104,233 @@ func (c *commPGRepo) Update(ctx context.Context, comment *models.Comment) (*mode
 		&comm.Rating,
 		&comm.CreatedAt,
 		&comm.UpdatedAt,
+		&comm.ParentCommentID,
+		&comm.HelpfulCount,
+		&comm.Hidden,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &comm, nil
+}
+
+// CreateReply
+func (c *commPGRepo) CreateReply(ctx context.Context, reply *models.Comment) (*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.CreateReply")
+	defer span.Finish()
+
+	var comm models.Comment
+	if err := c.db.QueryRow(ctx, createReplyQuery, reply.HotelID, reply.UserID, reply.Message, reply.ParentCommentID).Scan(
+		&comm.CommentID,
+		&comm.HotelID,
+		&comm.UserID,
+		&comm.Message,
+		&comm.Photos,
+		&comm.Rating,
+		&comm.CreatedAt,
+		&comm.UpdatedAt,
+		&comm.ParentCommentID,
+		&comm.HelpfulCount,
+		&comm.Hidden,
 	); err != nil {
+		if strings.Contains(err.Error(), uniqueViolationCode) {
+			return nil, grpcErrors.ErrReplyExists
+		}
 		return nil, errors.Wrap(err, "Scan")
 	}
 
 	return &comm, nil
 }
 
+// UpdateReply
+func (c *commPGRepo) UpdateReply(ctx context.Context, reply *models.Comment) (*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.UpdateReply")
+	defer span.Finish()
+
+	var comm models.Comment
+	if err := c.db.QueryRow(ctx, updateReplyQuery, reply.Message, reply.CommentID).Scan(
+		&comm.CommentID,
+		&comm.HotelID,
+		&comm.UserID,
+		&comm.Message,
+		&comm.Photos,
+		&comm.Rating,
+		&comm.CreatedAt,
+		&comm.UpdatedAt,
+		&comm.ParentCommentID,
+		&comm.HelpfulCount,
+		&comm.Hidden,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &comm, nil
+}
+
+// GetReplies get replies of given comments
+func (c *commPGRepo) GetReplies(ctx context.Context, parentIDs []uuid.UUID) ([]*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.GetReplies")
+	defer span.Finish()
+
+	if len(parentIDs) == 0 {
+		return make([]*models.Comment, 0), nil
+	}
+
+	ids := make([]string, 0, len(parentIDs))
+	for _, id := range parentIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := c.db.Query(ctx, getRepliesQuery, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	return c.scanComments(rows, len(parentIDs))
+}
+
+// AddPhoto append uploaded photo url to comment photos up to maxPhotos
+func (c *commPGRepo) AddPhoto(ctx context.Context, commentID uuid.UUID, photoURL string, maxPhotos int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.AddPhoto")
+	defer span.Finish()
+
+	result, err := c.db.Exec(ctx, addPhotoQuery, photoURL, commentID, maxPhotos)
+	if err != nil {
+		return errors.Wrap(err, "Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return errors.Wrapf(comments_errors.ErrTooManyPhotos, "comment: %s", commentID.String())
+	}
+
+	return nil
+}
+
+// Delete
+func (c *commPGRepo) Delete(ctx context.Context, commentID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.Delete")
+	defer span.Finish()
+
+	result, err := c.db.Exec(ctx, deleteCommentQuery, commentID)
+	if err != nil {
+		return errors.Wrap(err, "Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return grpcErrors.ErrNotFound
+	}
+
+	return nil
+}
+
+// ExistsByHotelAndUser check if user already reviewed the hotel
+func (c *commPGRepo) ExistsByHotelAndUser(ctx context.Context, hotelID uuid.UUID, userID uuid.UUID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.ExistsByHotelAndUser")
+	defer span.Finish()
+
+	var exists bool
+	if err := c.db.QueryRow(ctx, existsByHotelAndUserQuery, hotelID, userID).Scan(&exists); err != nil {
+		return false, errors.Wrap(err, "Scan")
+	}
+
+	return exists, nil
+}
+
 // GetByHotelID
-func (c *commPGRepo) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *utils.Pagination) (*models.CommentsList, error) {
+func (c *commPGRepo) GetByHotelID(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	query *utils.Pagination,
+	filter *models.CommentsFilter,
+) (*models.CommentsList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.GetByHotelID")
 	defer span.Finish()
 
+	where, args := buildCommentsFilter(hotelIDColumn, hotelID, filter)
+	return c.getComments(ctx, query, where, args)
+}
+
+// GetByUserID
+func (c *commPGRepo) GetByUserID(ctx context.Context, userID uuid.UUID, query *utils.Pagination) (*models.CommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.GetByUserID")
+	defer span.Finish()
+
+	where, args := buildCommentsFilter(userIDColumn, userID, nil)
+	return c.getComments(ctx, query, where, args)
+}
+
+// GetHidden comments pending moderator review, hidden by abuse reports or flagged as duplicate reviews
+func (c *commPGRepo) GetHidden(ctx context.Context, query *utils.Pagination) (*models.CommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.GetHidden")
+	defer span.Finish()
+
+	return c.getComments(ctx, query, moderationFilter, []interface{}{})
+}
+
+// VoteHelpful add user helpful vote, returns comment helpful votes count
+func (c *commPGRepo) VoteHelpful(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.VoteHelpful")
+	defer span.Finish()
+
+	var helpfulCount int
+	if err := c.db.QueryRow(ctx, voteHelpfulQuery, commentID, userID).Scan(&helpfulCount); err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, grpcErrors.ErrVoteExists
+		}
+		return 0, errors.Wrap(err, "Scan")
+	}
+
+	return helpfulCount, nil
+}
+
+// Report add user abuse report, returns true if comment is hidden
+func (c *commPGRepo) Report(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, reason string, threshold int) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.Report")
+	defer span.Finish()
+
+	var hidden bool
+	if err := c.db.QueryRow(ctx, reportCommentQuery, commentID, userID, reason, threshold).Scan(&hidden); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, grpcErrors.ErrReportExists
+		}
+		return false, errors.Wrap(err, "Scan")
+	}
+
+	return hidden, nil
+}
+
+// Restore make comment pending review visible again, clear its reports and review flag
+func (c *commPGRepo) Restore(ctx context.Context, commentID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.Restore")
+	defer span.Finish()
+
+	result, err := c.db.Exec(ctx, restoreCommentQuery, commentID)
+	if err != nil {
+		return errors.Wrap(err, "Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return grpcErrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *commPGRepo) getComments(
+	ctx context.Context,
+	query *utils.Pagination,
+	where string,
+	args []interface{},
+) (*models.CommentsList, error) {
+	orderBy, err := buildCommentsOrderBy(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.GetCursor() != nil {
+		return c.getCommentsByCursor(ctx, query, where, orderBy, args)
+	}
+
 	var totalCount int
-	if err := c.db.QueryRow(ctx, getTotalCountQuery, hotelID).Scan(&totalCount); err != nil {
+	if err := c.db.QueryRow(ctx, getTotalCountQuery+where, args...).Scan(&totalCount); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
 
@@ -117,13 +345,93 @@ func (c *commPGRepo) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query
 		}, nil
 	}
 
-	var commentsList []*models.Comment
-	rows, err := c.db.Query(ctx, getCommentByHotelIDQuery, hotelID, query.GetOffset(), query.GetLimit())
+	args = append(args, query.GetOffset(), query.GetLimit())
+	commentsQuery := fmt.Sprintf("%s%s%s OFFSET $%d LIMIT $%d", getCommentsQuery, where, orderBy, len(args)-1, len(args))
+
+	rows, err := c.db.Query(ctx, commentsQuery, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "db.Query")
 	}
 	defer rows.Close()
 
+	commentsList, err := c.scanComments(rows, query.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := query.GetHasMore(totalCount)
+	var nextCursor string
+	if hasMore && len(commentsList) > 0 && isCreatedAtSort(query) {
+		last := commentsList[len(commentsList)-1]
+		nextCursor = utils.EncodeCursor(*last.CreatedAt, last.CommentID)
+	}
+
+	return &models.CommentsList{
+		TotalCount: totalCount,
+		TotalPages: query.GetTotalPages(totalCount),
+		Page:       query.GetPage(),
+		Size:       query.GetSize(),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+		Comments:   commentsList,
+	}, nil
+}
+
+// getCommentsByCursor keyset pagination by (created_at, comment_id), fetch one extra row instead of total count
+func (c *commPGRepo) getCommentsByCursor(
+	ctx context.Context,
+	query *utils.Pagination,
+	where string,
+	orderBy string,
+	args []interface{},
+) (*models.CommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commPGRepo.getCommentsByCursor")
+	defer span.Finish()
+
+	if !isCreatedAtSort(query) {
+		return nil, errors.Wrap(comments_errors.ErrInvalidCursorSort, "Validate cursor")
+	}
+
+	cursor := query.GetCursor()
+	args = append(args, cursor.CreatedAt, cursor.ID)
+	if query.GetOrderBy() == sortNewest {
+		where += fmt.Sprintf(" AND (created_at, comment_id) < ($%d, $%d)", len(args)-1, len(args))
+	} else {
+		where += fmt.Sprintf(" AND (created_at, comment_id) > ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, query.GetLimit()+1)
+	commentsQuery := fmt.Sprintf("%s%s%s LIMIT $%d", getCommentsQuery, where, orderBy, len(args))
+
+	rows, err := c.db.Query(ctx, commentsQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	commentsList, err := c.scanComments(rows, query.GetLimit()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(commentsList) > query.GetLimit()
+	var nextCursor string
+	if hasMore {
+		commentsList = commentsList[:query.GetLimit()]
+		last := commentsList[len(commentsList)-1]
+		nextCursor = utils.EncodeCursor(*last.CreatedAt, last.CommentID)
+	}
+
+	return &models.CommentsList{
+		Size:       query.GetSize(),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+		Comments:   commentsList,
+	}, nil
+}
+
+func (c *commPGRepo) scanComments(rows pgx.Rows, size int) ([]*models.Comment, error) {
+	commentsList := make([]*models.Comment, 0, size)
 	for rows.Next() {
 		var comm models.Comment
 		if err := rows.Scan(
@@ -135,6 +443,9 @@ func (c *commPGRepo) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query
 			&comm.Rating,
 			&comm.CreatedAt,
 			&comm.UpdatedAt,
+			&comm.ParentCommentID,
+			&comm.HelpfulCount,
+			&comm.Hidden,
 		); err != nil {
 			return nil, errors.Wrap(err, "Scan")
 		}
@@ -145,12 +456,5 @@ func (c *commPGRepo) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query
 		return nil, errors.Wrap(err, "rows.Err")
 	}
 
-	return &models.CommentsList{
-		TotalCount: totalCount,
-		TotalPages: query.GetTotalPages(totalCount),
-		Page:       query.GetPage(),
-		Size:       query.GetSize(),
-		HasMore:    query.GetHasMore(totalCount),
-		Comments:   commentsList,
-	}, nil
+	return commentsList, nil
 }
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/comments_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/utils"
+)
+
+const (
+	// uniqueViolationCode postgres unique constraint violation, one review per user per hotel
+	uniqueViolationCode = "SQLSTATE 23505"
+
+	hotelIDColumn = "hotel_id"
+	userIDColumn  = "user_id"
+
+	sortNewest  = "newest"
+	sortOldest  = "oldest"
+	sortHighest = "highest"
+	sortLowest  = "lowest"
+	sortHelpful = "helpful"
+
+	moderationFilter = " WHERE hidden OR review_pending"
+)
+
+// commentsSortOrders whitelist of comments list sort options
+var commentsSortOrders = map[string]string{
+	sortNewest:  " ORDER BY created_at DESC, comment_id DESC",
+	sortOldest:  " ORDER BY created_at ASC, comment_id ASC",
+	sortHighest: " ORDER BY rating DESC, created_at DESC, comment_id DESC",
+	sortLowest:  " ORDER BY rating ASC, created_at ASC, comment_id ASC",
+	sortHelpful: " ORDER BY helpful_count DESC, created_at DESC, comment_id DESC",
+}
+
+// buildCommentsFilter build WHERE clause of top level comments by owner column (hotel_id or user_id) and rating range,
+// comments hidden by abuse reports are excluded from hotel comments but still listed to their authors
+func buildCommentsFilter(column string, id uuid.UUID, filter *models.CommentsFilter) (string, []interface{}) {
+	args := []interface{}{id}
+	where := fmt.Sprintf(" WHERE %s = $1 AND parent_comment_id IS NULL", column)
+	if column == hotelIDColumn {
+		where += " AND NOT hidden"
+	}
+
+	if filter == nil {
+		return where, args
+	}
+	if filter.MinRating > 0 {
+		args = append(args, filter.MinRating)
+		where += fmt.Sprintf(" AND rating >= $%d", len(args))
+	}
+	if filter.MaxRating > 0 {
+		args = append(args, filter.MaxRating)
+		where += fmt.Sprintf(" AND rating <= $%d", len(args))
+	}
+
+	return where, args
+}
+
+// buildCommentsOrderBy validate sort against whitelist, oldest first by default
+func buildCommentsOrderBy(query *utils.Pagination) (string, error) {
+	sort := query.GetOrderBy()
+	if sort == "" {
+		sort = sortOldest
+	}
+
+	orderBy, ok := commentsSortOrders[sort]
+	if !ok {
+		return "", errors.Wrapf(comments_errors.ErrInvalidSort, "Validate sort: %s", sort)
+	}
+
+	return orderBy, nil
+}
+
+func isCreatedAtSort(query *utils.Pagination) bool {
+	switch query.GetOrderBy() {
+	case "", sortOldest, sortNewest:
+		return true
+	default:
+		return false
+	}
+}
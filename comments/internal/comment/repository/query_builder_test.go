@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/comments_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/utils"
+)
+
+func TestBuildCommentsOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    string
+		want    string
+		wantErr bool
+	}{
+		{name: "default oldest", want: commentsSortOrders[sortOldest]},
+		{name: "newest", sort: sortNewest, want: commentsSortOrders[sortNewest]},
+		{name: "highest", sort: sortHighest, want: commentsSortOrders[sortHighest]},
+		{name: "lowest", sort: sortLowest, want: commentsSortOrders[sortLowest]},
+		{name: "helpful", sort: sortHelpful, want: commentsSortOrders[sortHelpful]},
+		{name: "column name is not sort option", sort: "rating", wantErr: true},
+		{name: "sql injection", sort: "newest; DROP TABLE comments", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := utils.NewPaginationQuery(10, 1)
+			query.SetOrderBy(tt.sort)
+
+			orderBy, err := buildCommentsOrderBy(query)
+			if tt.wantErr {
+				if errors.Cause(err) != comments_errors.ErrInvalidSort {
+					t.Fatalf("buildCommentsOrderBy error = %v, want %v", err, comments_errors.ErrInvalidSort)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildCommentsOrderBy: %v", err)
+			}
+			if orderBy != tt.want {
+				t.Errorf("orderBy = %q, want %q", orderBy, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCreatedAtSort(t *testing.T) {
+	tests := []struct {
+		sort string
+		want bool
+	}{
+		{sort: "", want: true},
+		{sort: sortOldest, want: true},
+		{sort: sortNewest, want: true},
+		{sort: sortHighest, want: false},
+		{sort: sortHelpful, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			query := utils.NewPaginationQuery(10, 1)
+			query.SetOrderBy(tt.sort)
+
+			if got := isCreatedAtSort(query); got != tt.want {
+				t.Errorf("isCreatedAtSort(%q) = %v, want %v", tt.sort, got, tt.want)
+			}
+		})
+	}
+}
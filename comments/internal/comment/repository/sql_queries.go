@@ -2,16 +2,54 @@ package repository
 
 const (
 	createCommentQuery = `INSERT INTO comments (hotel_id, user_id, message, photos, rating) 
-	VALUES ($1, $2, $3, $4, $5) RETURNING comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at`
+	VALUES ($1, $2, $3, $4, $5) RETURNING comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden`
 
-	getCommByIDQuery = `SELECT comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at FROM comments WHERE comment_id = $1`
+	getCommByIDQuery = `SELECT comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden FROM comments WHERE comment_id = $1`
 
 	updateCommentQuery = `UPDATE comments SET message = COALESCE(NULLIF($1, ''), message), rating = $2, photos = $3
 	WHERE comment_id = $4
-	RETURNING comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at`
+	RETURNING comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden`
 
-	getTotalCountQuery = `SELECT count(comment_id) as total FROM comments WHERE hotel_id = $1`
+	addPhotoQuery = `UPDATE comments SET photos = array_append(photos, $1)
+	WHERE comment_id = $2 AND COALESCE(array_length(photos, 1), 0) < $3`
 
-	getCommentByHotelIDQuery = `SELECT comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at FROM comments
-	WHERE hotel_id = $1 OFFSET $2 LIMIT $3`
+	deleteCommentQuery = `DELETE FROM comments WHERE comment_id = $1`
+
+	existsByHotelAndUserQuery = `SELECT EXISTS(SELECT 1 FROM comments WHERE hotel_id = $1 AND user_id = $2 AND parent_comment_id IS NULL)`
+
+	createReplyQuery = `INSERT INTO comments (hotel_id, user_id, message, parent_comment_id) 
+	VALUES ($1, $2, $3, $4) RETURNING comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden`
+
+	updateReplyQuery = `UPDATE comments SET message = $1
+	WHERE comment_id = $2 AND parent_comment_id IS NOT NULL
+	RETURNING comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden`
+
+	getRepliesQuery = `SELECT comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden 
+	FROM comments WHERE parent_comment_id = ANY($1::uuid[]) AND NOT hidden ORDER BY created_at, comment_id`
+
+	// voteHelpfulQuery counts vote only once per user, no rows returned if user already voted
+	voteHelpfulQuery = `WITH vote AS (
+		INSERT INTO comment_helpful_votes (comment_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING RETURNING comment_id
+	)
+	UPDATE comments SET helpful_count = helpful_count + 1
+	WHERE comment_id = (SELECT comment_id FROM vote)
+	RETURNING helpful_count`
+
+	// reportCommentQuery counts report only once per user and hides comment when reports reach threshold
+	reportCommentQuery = `WITH report AS (
+		INSERT INTO comment_reports (comment_id, user_id, reason) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING comment_id
+	)
+	UPDATE comments SET reports_count = reports_count + 1, hidden = hidden OR reports_count + 1 >= $4
+	WHERE comment_id = (SELECT comment_id FROM report)
+	RETURNING hidden`
+
+	// restoreCommentQuery approve comment pending review, reports are cleared so comment can be reported again
+	restoreCommentQuery = `WITH reports AS (
+		DELETE FROM comment_reports WHERE comment_id = $1
+	)
+	UPDATE comments SET hidden = false, review_pending = false, reports_count = 0 WHERE comment_id = $1 AND (hidden OR review_pending)`
+
+	getTotalCountQuery = `SELECT count(comment_id) as total FROM comments`
+
+	getCommentsQuery = `SELECT comment_id, hotel_id, user_id, message, photos, rating, created_at, updated_at, parent_comment_id, helpful_count, hidden FROM comments`
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/streadway/amqp"
 
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/utils"
@@ -14,5 +15,15 @@ type UseCase interface {
 	Create(ctx context.Context, comment *models.Comment) (*models.Comment, error)
 	GetByID(ctx context.Context, commentID uuid.UUID) (*models.Comment, error)
 	Update(ctx context.Context, comment *models.Comment) (*models.Comment, error)
-	GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *utils.Pagination) (*models.CommentsFullList, error)
+	Delete(ctx context.Context, commentID uuid.UUID) error
+	CreateReply(ctx context.Context, parentID uuid.UUID, message string) (*models.Comment, error)
+	UpdateReply(ctx context.Context, replyID uuid.UUID, message string) (*models.Comment, error)
+	UploadPhoto(ctx context.Context, msg *models.UploadCommentPhotoMsg) error
+	AddPhoto(ctx context.Context, delivery amqp.Delivery) error
+	GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *utils.Pagination, filter *models.CommentsFilter) (*models.CommentsFullList, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, query *utils.Pagination) (*models.CommentsList, error)
+	VoteHelpful(ctx context.Context, commentID uuid.UUID) (int, error)
+	Report(ctx context.Context, commentID uuid.UUID, reason string) error
+	GetHidden(ctx context.Context, query *utils.Pagination) (*models.CommentsList, error)
+	Review(ctx context.Context, commentID uuid.UUID, approve bool) error
 }
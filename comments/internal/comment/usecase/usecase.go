@@ -2,33 +2,86 @@ package usecase
 
 import (
 	"context"
+	"net/url"
+	"path"
+	"strings"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/streadway/amqp"
 
+	"github.com/AleksK1NG/hotels-mocroservices/comments/config"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment/delivery/rabbitmq"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/comments_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/events"
+	grpcErrors "github.com/AleksK1NG/hotels-mocroservices/comments/pkg/grpc_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/logger"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/utils"
+	eventsService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/events"
+	hotelsService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/hotels"
 	userService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/user"
 )
 
+const (
+	imagesExchange               = "images"
+	uploadCommentPhotoRoutingKey = "upload_comment_photo_binding_key"
+)
+
 // CommUseCase
 type commUseCase struct {
-	commRepo   comment.PGRepository
-	logger     logger.Logger
-	userClient userService.UserServiceClient
+	cfg           *config.Config
+	commRepo      comment.PGRepository
+	logger        logger.Logger
+	userClient    userService.UserServiceClient
+	hotelsClient  hotelsService.HotelsServiceClient
+	amqpPublisher rabbitmq.Publisher
 }
 
 // NewCommUseCase
-func NewCommUseCase(commRepo comment.PGRepository, logger logger.Logger, userClient userService.UserServiceClient) *commUseCase {
-	return &commUseCase{commRepo: commRepo, logger: logger, userClient: userClient}
+func NewCommUseCase(
+	cfg *config.Config,
+	commRepo comment.PGRepository,
+	logger logger.Logger,
+	userClient userService.UserServiceClient,
+	hotelsClient hotelsService.HotelsServiceClient,
+	amqpPublisher rabbitmq.Publisher,
+) *commUseCase {
+	return &commUseCase{
+		cfg:           cfg,
+		commRepo:      commRepo,
+		logger:        logger,
+		userClient:    userClient,
+		hotelsClient:  hotelsClient,
+		amqpPublisher: amqpPublisher,
+	}
 }
 
-// Create
+// Create author of comment is request user from metadata
 func (c *commUseCase) Create(ctx context.Context, comment *models.Comment) (*models.Comment, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.Create")
 	defer span.Finish()
+
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comment.UserID = userID
+
+	if err := c.validatePhotos(comment.Photos); err != nil {
+		return nil, err
+	}
+
+	exists, err := c.commRepo.ExistsByHotelAndUser(ctx, comment.HotelID, comment.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, grpcErrors.ErrCommentExists
+	}
+
 	return c.commRepo.Create(ctx, comment)
 }
 
@@ -43,30 +96,367 @@ func (c *commUseCase) GetByID(ctx context.Context, commentID uuid.UUID) (*models
 func (c *commUseCase) Update(ctx context.Context, comment *models.Comment) (*models.Comment, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.Update")
 	defer span.Finish()
+
+	if err := c.validatePhotos(comment.Photos); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkAuthorOrModerator(ctx, comment.CommentID); err != nil {
+		return nil, err
+	}
+
 	return c.commRepo.Update(ctx, comment)
 }
 
+// Delete
+func (c *commUseCase) Delete(ctx context.Context, commentID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.Delete")
+	defer span.Finish()
+
+	if err := c.checkAuthorOrModerator(ctx, commentID); err != nil {
+		return err
+	}
+
+	return c.commRepo.Delete(ctx, commentID)
+}
+
+// CreateReply reply of hotel owner or moderator to top level comment
+func (c *commUseCase) CreateReply(ctx context.Context, parentID uuid.UUID, message string) (*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.CreateReply")
+	defer span.Finish()
+
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := c.commRepo.GetByID(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ParentCommentID != nil {
+		return nil, errors.Wrapf(comments_errors.ErrNestedReply, "Validate parent comment: %s", parentID.String())
+	}
+
+	if err := c.checkHotelOwnerOrModerator(ctx, parent.HotelID, userID); err != nil {
+		return nil, err
+	}
+
+	return c.commRepo.CreateReply(ctx, &models.Comment{
+		HotelID:         parent.HotelID,
+		UserID:          userID,
+		Message:         message,
+		ParentCommentID: &parentID,
+	})
+}
+
+// UpdateReply
+func (c *commUseCase) UpdateReply(ctx context.Context, replyID uuid.UUID, message string) (*models.Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.UpdateReply")
+	defer span.Finish()
+
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := c.commRepo.GetByID(ctx, replyID)
+	if err != nil {
+		return nil, err
+	}
+	if reply.ParentCommentID == nil {
+		return nil, errors.Wrapf(comments_errors.ErrNotReply, "Validate reply: %s", replyID.String())
+	}
+
+	if err := c.checkHotelOwnerOrModerator(ctx, reply.HotelID, userID); err != nil {
+		return nil, err
+	}
+
+	reply.Message = message
+	return c.commRepo.UpdateReply(ctx, reply)
+}
+
+// UploadPhoto send comment photo to images service, processed photo url comes back with AddPhoto
+func (c *commUseCase) UploadPhoto(ctx context.Context, msg *models.UploadCommentPhotoMsg) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.UploadPhoto")
+	defer span.Finish()
+
+	if err := c.checkAuthorOrModerator(ctx, msg.CommentID); err != nil {
+		return err
+	}
+
+	msgBytes, err := events.Marshal(ctx, &eventsService.Envelope{
+		Payload: &eventsService.Envelope_UploadCommentPhoto{UploadCommentPhoto: &eventsService.UploadCommentPhoto{
+			CommentID:   msg.CommentID.String(),
+			ContentType: msg.ContentType,
+			Data:        msg.Data,
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "UploadPhoto.events.Marshal")
+	}
+
+	if err := c.amqpPublisher.Publish(
+		ctx,
+		imagesExchange,
+		uploadCommentPhotoRoutingKey,
+		events.ContentType,
+		nil,
+		msgBytes,
+	); err != nil {
+		return errors.Wrap(err, "UploadPhoto.Publish")
+	}
+
+	return nil
+}
+
+// AddPhoto attach photo uploaded by images service to comment
+func (c *commUseCase) AddPhoto(ctx context.Context, delivery amqp.Delivery) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.AddPhoto")
+	defer span.Finish()
+
+	env, err := events.Unmarshal(delivery.Body, events.CommentPhotoUploadedType)
+	if err != nil {
+		return errors.Wrap(err, "AddPhoto.events.Unmarshal")
+	}
+	msg := env.GetCommentPhotoUploaded()
+
+	commentID, err := uuid.FromString(msg.GetCommentID())
+	if err != nil {
+		return errors.Wrap(err, "uuid.FromString")
+	}
+
+	if err := c.validatePhotoURL(msg.GetImageURL()); err != nil {
+		return err
+	}
+
+	return c.commRepo.AddPhoto(ctx, commentID, msg.GetImageURL(), c.cfg.Photos.MaxCount)
+}
+
+// validatePhotos accept only photos uploaded to images bucket
+func (c *commUseCase) validatePhotos(photos []string) error {
+	if len(photos) > c.cfg.Photos.MaxCount {
+		return errors.Wrapf(comments_errors.ErrTooManyPhotos, "Validate photos: %d", len(photos))
+	}
+
+	for _, photo := range photos {
+		if err := c.validatePhotoURL(photo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *commUseCase) validatePhotoURL(photo string) error {
+	photoURL, err := url.Parse(photo)
+	if err != nil || !strings.HasPrefix(photo, c.cfg.Photos.BucketURL) || path.Clean(photoURL.Path) != photoURL.Path {
+		return errors.Wrapf(comments_errors.ErrInvalidPhotoURL, "Validate photo url: %s", photo)
+	}
+
+	return nil
+}
+
+// checkAuthorOrModerator allow only comment author or user with comment:moderate permission from request metadata user id
+func (c *commUseCase) checkAuthorOrModerator(ctx context.Context, commentID uuid.UUID) error {
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	comm, err := c.commRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if uuid.Equal(comm.UserID, userID) {
+		return nil
+	}
+
+	canModerate, err := c.hasPermission(ctx, userID, userService.Permission_COMMENT_MODERATE)
+	if err != nil {
+		return err
+	}
+	if !canModerate {
+		c.logger.Warnf("user: %s is not author of comment: %s", userID.String(), commentID.String())
+		return grpcErrors.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// VoteHelpful up-vote comment as helpful, one vote per user
+func (c *commUseCase) VoteHelpful(ctx context.Context, commentID uuid.UUID) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.VoteHelpful")
+	defer span.Finish()
+
+	userID, err := c.checkNotAuthor(ctx, commentID)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.commRepo.VoteHelpful(ctx, commentID, userID)
+}
+
+// Report abuse report, comment is hidden pending admin review when reports reach threshold
+func (c *commUseCase) Report(ctx context.Context, commentID uuid.UUID, reason string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.Report")
+	defer span.Finish()
+
+	userID, err := c.checkNotAuthor(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	hidden, err := c.commRepo.Report(ctx, commentID, userID, reason, c.cfg.Moderation.ReportsThreshold)
+	if err != nil {
+		return err
+	}
+	if hidden {
+		c.logger.Infof("comment: %s is hidden pending admin review", commentID.String())
+	}
+
+	return nil
+}
+
+// GetHidden comments hidden by abuse reports or flagged as duplicate reviews, moderators only
+func (c *commUseCase) GetHidden(ctx context.Context, query *utils.Pagination) (*models.CommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.GetHidden")
+	defer span.Finish()
+
+	if err := c.checkPermission(ctx, userService.Permission_COMMENT_MODERATE); err != nil {
+		return nil, err
+	}
+
+	return c.commRepo.GetHidden(ctx, query)
+}
+
+// Review moderator decision on comment pending review, approved comment is visible again, rejected one is deleted
+func (c *commUseCase) Review(ctx context.Context, commentID uuid.UUID, approve bool) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.Review")
+	defer span.Finish()
+
+	if err := c.checkPermission(ctx, userService.Permission_COMMENT_MODERATE); err != nil {
+		return err
+	}
+
+	if approve {
+		return c.commRepo.Restore(ctx, commentID)
+	}
+	return c.commRepo.Delete(ctx, commentID)
+}
+
+// checkNotAuthor users can not vote for or report own comments, returns request metadata user id
+func (c *commUseCase) checkNotAuthor(ctx context.Context, commentID uuid.UUID) (uuid.UUID, error) {
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	comm, err := c.commRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if uuid.Equal(comm.UserID, userID) {
+		return uuid.Nil, errors.Wrapf(comments_errors.ErrOwnCommentVote, "Validate comment: %s", commentID.String())
+	}
+
+	return userID, nil
+}
+
+// checkPermission allow only users whose role grants permission, role is loaded by request metadata user id
+func (c *commUseCase) checkPermission(ctx context.Context, permission userService.Permission) error {
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := c.hasPermission(ctx, userID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		c.logger.Warnf("user: %s has no permission: %v", userID.String(), permission)
+		return grpcErrors.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// checkHotelOwnerOrModerator allow only hotel owner or user with comment:moderate permission from request metadata user id
+func (c *commUseCase) checkHotelOwnerOrModerator(ctx context.Context, hotelID uuid.UUID, userID uuid.UUID) error {
+	hotelRes, err := c.hotelsClient.GetHotelByID(ctx, &hotelsService.GetByIDReq{HotelID: hotelID.String()})
+	if err != nil {
+		return err
+	}
+	if hotelRes.GetHotel().GetOwnerID() == userID.String() {
+		return nil
+	}
+
+	canModerate, err := c.hasPermission(ctx, userID, userService.Permission_COMMENT_MODERATE)
+	if err != nil {
+		return err
+	}
+	if !canModerate {
+		c.logger.Warnf("user: %s is not owner of hotel: %s", userID.String(), hotelID.String())
+		return grpcErrors.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// hasPermission check user loaded by id was granted permission by user service, roles are never mapped to permissions here
+func (c *commUseCase) hasPermission(ctx context.Context, userID uuid.UUID, permission userService.Permission) (bool, error) {
+	userRes, err := c.userClient.GetUserByID(ctx, &userService.GetByIDRequest{UserID: userID.String()})
+	if err != nil {
+		return false, err
+	}
+	for _, granted := range userRes.GetUser().GetPermissions() {
+		if granted == permission {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // GetByHotelID
-func (c *commUseCase) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query *utils.Pagination) (*models.CommentsFullList, error) {
+func (c *commUseCase) GetByHotelID(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	query *utils.Pagination,
+	filter *models.CommentsFilter,
+) (*models.CommentsFullList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.GetByHotelID")
 	defer span.Finish()
 
-	commentsList, err := c.commRepo.GetByHotelID(ctx, hotelID, query)
+	commentsList, err := c.commRepo.GetByHotelID(ctx, hotelID, query, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	uniqUserIDsMap := make(map[string]struct{}, len(commentsList.Comments))
+	parentIDs := make([]uuid.UUID, 0, len(commentsList.Comments))
+	for _, comm := range commentsList.Comments {
+		parentIDs = append(parentIDs, comm.CommentID)
+	}
+
+	replies, err := c.commRepo.GetReplies(ctx, parentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	uniqUserIDsMap := make(map[string]struct{}, len(commentsList.Comments)+len(replies))
 	for _, comm := range commentsList.Comments {
 		uniqUserIDsMap[comm.UserID.String()] = struct{}{}
 	}
+	for _, reply := range replies {
+		uniqUserIDsMap[reply.UserID.String()] = struct{}{}
+	}
 
-	userIDS := make([]string, 0, len(commentsList.Comments))
-	for key, _ := range uniqUserIDsMap {
+	userIDS := make([]string, 0, len(uniqUserIDsMap))
+	for key := range uniqUserIDsMap {
 		userIDS = append(userIDS, key)
 	}
 
-	usersByIDs, err := c.userClient.GetUsersByIDs(ctx, &userService.GetByIDsReq{UsersIDs: userIDS})
+	profiles, err := c.userClient.GetPublicProfiles(ctx, &userService.GetPublicProfilesReq{UsersIDs: userIDS})
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +467,15 @@ func (c *commUseCase) GetByHotelID(ctx context.Context, hotelID uuid.UUID, query
 		Page:       commentsList.Page,
 		Size:       commentsList.Size,
 		HasMore:    commentsList.HasMore,
-		Comments:   commentsList.ToHotelByIDProto(usersByIDs.GetUsers()),
+		NextCursor: commentsList.NextCursor,
+		Comments:   commentsList.ToHotelByIDProto(profiles.GetProfiles(), replies),
 	}, nil
 }
+
+// GetByUserID
+func (c *commUseCase) GetByUserID(ctx context.Context, userID uuid.UUID, query *utils.Pagination) (*models.CommentsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "commUseCase.GetByUserID")
+	defer span.Finish()
+
+	return c.commRepo.GetByUserID(ctx, userID, query)
+}
@@ -12,30 +12,79 @@ import (
 
 // Comment
 type Comment struct {
-	CommentID uuid.UUID  `json:"comment_id"`
-	HotelID   uuid.UUID  `json:"hotel_id"`
-	UserID    uuid.UUID  `json:"user_id"`
-	Message   string     `json:"message" validate:"required,min=5,max=500"`
-	Photos    []string   `json:"photos,omitempty"`
-	Rating    float64    `json:"rating" validate:"required,min=0,max=10"`
-	CreatedAt *time.Time `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
+	CommentID       uuid.UUID  `json:"comment_id"`
+	HotelID         uuid.UUID  `json:"hotel_id"`
+	UserID          uuid.UUID  `json:"user_id"`
+	Message         string     `json:"message" validate:"required,min=5,max=500"`
+	Photos          []string   `json:"photos,omitempty"`
+	Rating          float64    `json:"rating" validate:"required,min=0,max=10"`
+	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+	ParentCommentID *uuid.UUID `json:"parent_comment_id,omitempty"`
+	HelpfulCount    int        `json:"helpful_count"`
+	Hidden          bool       `json:"hidden"`
+}
+
+// GetParentCommentID
+func (c *Comment) GetParentCommentID() string {
+	var parentID string
+	if c.ParentCommentID != nil {
+		parentID = c.ParentCommentID.String()
+	}
+	return parentID
 }
 
 // ToProto
 func (c *Comment) ToProto() *commentsService.Comment {
 	return &commentsService.Comment{
+		CommentID:       c.CommentID.String(),
+		HotelID:         c.HotelID.String(),
+		UserID:          c.UserID.String(),
+		Message:         c.Message,
+		Photos:          c.Photos,
+		Rating:          c.Rating,
+		CreatedAt:       timestamppb.New(*c.CreatedAt),
+		UpdatedAt:       timestamppb.New(*c.UpdatedAt),
+		ParentCommentID: c.GetParentCommentID(),
+		HelpfulCount:    int64(c.HelpfulCount),
+		Hidden:          c.Hidden,
+	}
+}
+
+// ToFullProto comment with author public profile and nested replies
+func (c *Comment) ToFullProto(profile *userService.PublicProfile, replies []*commentsService.CommentFull) *commentsService.CommentFull {
+	return &commentsService.CommentFull{
 		CommentID: c.CommentID.String(),
 		HotelID:   c.HotelID.String(),
-		UserID:    c.UserID.String(),
-		Message:   c.Message,
-		Photos:    c.Photos,
-		Rating:    c.Rating,
-		CreatedAt: timestamppb.New(*c.CreatedAt),
-		UpdatedAt: timestamppb.New(*c.UpdatedAt),
+		User: &commentsService.User{
+			UserID:      profile.GetUserID(),
+			Avatar:      profile.GetAvatar(),
+			DisplayName: profile.GetDisplayName(),
+			MemberSince: profile.GetMemberSince(),
+		},
+		Message:      c.Message,
+		Photos:       c.Photos,
+		Rating:       c.Rating,
+		CreatedAt:    timestamppb.New(*c.CreatedAt),
+		UpdatedAt:    timestamppb.New(*c.UpdatedAt),
+		Replies:      replies,
+		HelpfulCount: int64(c.HelpfulCount),
 	}
 }
 
+// UploadCommentPhotoMsg comment photo sent to images service
+type UploadCommentPhotoMsg struct {
+	CommentID   uuid.UUID `json:"comment_id"`
+	Data        []byte    `json:"data"`
+	ContentType string    `json:"content_type"`
+}
+
+// CommentsFilter rating range filter for hotel comments list
+type CommentsFilter struct {
+	MinRating float64 `json:"minRating"`
+	MaxRating float64 `json:"maxRating"`
+}
+
 // All Comments response with pagination
 type CommentsList struct {
 	TotalCount int        `json:"totalCount"`
@@ -43,6 +92,7 @@ type CommentsList struct {
 	Page       int        `json:"page"`
 	Size       int        `json:"size"`
 	HasMore    bool       `json:"hasMore"`
+	NextCursor string     `json:"nextCursor,omitempty"`
 	Comments   []*Comment `json:"comments"`
 }
 
@@ -53,6 +103,7 @@ type CommentsFullList struct {
 	Page       int                            `json:"page"`
 	Size       int                            `json:"size"`
 	HasMore    bool                           `json:"hasMore"`
+	NextCursor string                         `json:"nextCursor,omitempty"`
 	Comments   []*commentsService.CommentFull `json:"comments"`
 }
 
@@ -71,34 +122,25 @@ func (h *CommentsList) ToProto() []*commentsService.Comment {
 	return commentsList
 }
 
-// ToHotelByIDProto
-func (h *CommentsList) ToHotelByIDProto(users []*userService.User) []*commentsService.CommentFull {
-	userMap := make(map[string]*userService.User, len(users))
-	for _, user := range users {
-		userMap[user.UserID] = user
+// ToHotelByIDProto comments with authors public profiles, replies are nested into their parent comments
+func (h *CommentsList) ToHotelByIDProto(profiles []*userService.PublicProfile, replies []*Comment) []*commentsService.CommentFull {
+	userMap := make(map[string]*userService.PublicProfile, len(profiles))
+	for _, profile := range profiles {
+		userMap[profile.GetUserID()] = profile
+	}
+
+	repliesMap := make(map[uuid.UUID][]*commentsService.CommentFull, len(replies))
+	for _, reply := range replies {
+		if reply.ParentCommentID == nil {
+			continue
+		}
+		parentID := *reply.ParentCommentID
+		repliesMap[parentID] = append(repliesMap[parentID], reply.ToFullProto(userMap[reply.UserID.String()], nil))
 	}
 
 	commentsList := make([]*commentsService.CommentFull, 0, len(h.Comments))
 	for _, comm := range h.Comments {
-		user := userMap[comm.UserID.String()]
-
-		commentsList = append(commentsList, &commentsService.CommentFull{
-			CommentID: comm.CommentID.String(),
-			HotelID:   comm.HotelID.String(),
-			User: &commentsService.User{
-				UserID:    user.UserID,
-				FirstName: user.FirstName,
-				LastName:  user.LastName,
-				Email:     user.Email,
-				Avatar:    user.Avatar,
-				Role:      user.Role,
-			},
-			Message:   comm.Message,
-			Photos:    comm.Photos,
-			Rating:    comm.Rating,
-			CreatedAt: timestamppb.New(*comm.CreatedAt),
-			UpdatedAt: timestamppb.New(*comm.UpdatedAt),
-		})
+		commentsList = append(commentsList, comm.ToFullProto(userMap[comm.UserID.String()], repliesMap[comm.CommentID]))
 	}
 	return commentsList
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-redis/redis/v8"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -24,12 +25,15 @@ import (
 
 	"github.com/AleksK1NG/hotels-mocroservices/comments/config"
 	commGRPC "github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment/delivery/grpc"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment/delivery/rabbitmq"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment/repository"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/comment/usecase"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/internal/interceptors"
 	grpcClient "github.com/AleksK1NG/hotels-mocroservices/comments/internal/user/grpc"
 	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/logger"
+	redisPkg "github.com/AleksK1NG/hotels-mocroservices/comments/pkg/redis"
 	commentsService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/comments"
+	hotelsService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/hotels"
 	userService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/user"
 )
 
@@ -60,10 +64,27 @@ func (s *server) Run() error {
 		return errors.Wrap(err, "grpcClient.NewGRPCClientServiceConn")
 	}
 	defer userGRPCConn.Close()
-	userServiceClient := userService.NewUserServiceClient(userGRPCConn)
+	var redisClient *redis.Client
+	if s.cfg.UsersCache.RedisEnabled {
+		redisClient = redisPkg.NewRedisClient(s.cfg)
+		defer redisClient.Close()
+	}
+	userServiceClient := grpcClient.NewCachedUserClient(userService.NewUserServiceClient(userGRPCConn), s.cfg, s.logger, redisClient)
+
+	hotelsGRPCConn, err := grpcClient.NewGRPCClientServiceConn(ctx, im, s.cfg.GRPCServer.HotelsGrpcServicePort)
+	if err != nil {
+		return errors.Wrap(err, "grpcClient.NewGRPCClientServiceConn")
+	}
+	defer hotelsGRPCConn.Close()
+	hotelsServiceClient := hotelsService.NewHotelsServiceClient(hotelsGRPCConn)
+
+	commPublisher, err := rabbitmq.NewCommentsPublisher(s.cfg, s.logger)
+	if err != nil {
+		return errors.Wrap(err, "NewCommentsPublisher")
+	}
 
 	commPGRepo := repository.NewCommPGRepo(s.pgxPool)
-	commUC := usecase.NewCommUseCase(commPGRepo, s.logger, userServiceClient)
+	commUC := usecase.NewCommUseCase(s.cfg, commPGRepo, s.logger, userServiceClient, hotelsServiceClient, commPublisher)
 	commService := commGRPC.NewCommentsService(commUC, s.logger, s.cfg, validate)
 
 	l, err := net.Listen("tcp", s.cfg.GRPCServer.Port)
@@ -72,6 +93,13 @@ func (s *server) Run() error {
 	}
 	defer l.Close()
 
+	commConsumer := rabbitmq.NewCommentsConsumer(s.logger, s.cfg, commUC, userServiceClient)
+	if err := commConsumer.Initialize(); err != nil {
+		return errors.Wrap(err, "commConsumer.Initialize")
+	}
+	commConsumer.RunConsumers(ctx, cancel)
+	defer commConsumer.CloseChannels()
+
 	go func() {
 		router := echo.New()
 		router.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
@@ -125,6 +153,7 @@ func (s *server) Run() error {
 	}
 
 	server.GracefulStop()
+	commConsumer.Drain(s.cfg.RabbitMQ.DrainTimeout * time.Second)
 	s.logger.Info("Server Exited Properly")
 
 	return nil
@@ -0,0 +1,12 @@
+package user
+
+import (
+	"context"
+
+	"github.com/streadway/amqp"
+)
+
+// UsersCache comment authors cache of user service client
+type UsersCache interface {
+	InvalidateUser(ctx context.Context, delivery amqp.Delivery) error
+}
@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/streadway/amqp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/AleksK1NG/hotels-mocroservices/comments/config"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/events"
+	"github.com/AleksK1NG/hotels-mocroservices/comments/pkg/logger"
+	userService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/user"
+)
+
+// CachedUserClient user service client caching GetPublicProfiles results in LRU, optionally backed by redis.
+// When user service is unavailable last-known profiles are returned.
+type CachedUserClient struct {
+	userService.UserServiceClient
+	cfg         *config.Config
+	logger      logger.Logger
+	lru         *profilesLRU
+	redisClient *redis.Client
+}
+
+// NewCachedUserClient redisClient is optional, pass nil to use only in-process cache
+func NewCachedUserClient(
+	client userService.UserServiceClient,
+	cfg *config.Config,
+	logger logger.Logger,
+	redisClient *redis.Client,
+) *CachedUserClient {
+	return &CachedUserClient{
+		UserServiceClient: client,
+		cfg:               cfg,
+		logger:            logger,
+		lru:               newProfilesLRU(cfg.UsersCache.Size, cfg.UsersCache.TTL*time.Second),
+		redisClient:       redisClient,
+	}
+}
+
+// GetPublicProfiles
+func (c *CachedUserClient) GetPublicProfiles(ctx context.Context, in *userService.GetPublicProfilesReq, opts ...grpc.CallOption) (*userService.GetPublicProfilesRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CachedUserClient.GetPublicProfiles")
+	defer span.Finish()
+
+	profilesMap := make(map[string]*userService.PublicProfile, len(in.GetUsersIDs()))
+	staleMap := make(map[string]*userService.PublicProfile)
+	missing := make([]string, 0, len(in.GetUsersIDs()))
+	for _, userID := range in.GetUsersIDs() {
+		user, fresh, ok := c.lru.get(userID)
+		switch {
+		case ok && fresh:
+			profilesMap[userID] = user
+		case ok:
+			staleMap[userID] = user
+			missing = append(missing, userID)
+		default:
+			missing = append(missing, userID)
+		}
+	}
+
+	if len(missing) > 0 && c.redisClient != nil {
+		missing = c.getFromRedis(ctx, missing, profilesMap)
+	}
+
+	if len(missing) > 0 {
+		res, err := c.UserServiceClient.GetPublicProfiles(ctx, &userService.GetPublicProfilesReq{UsersIDs: missing}, opts...)
+		if err != nil {
+			c.logger.Warnf("UserServiceClient.GetPublicProfiles, using last-known profiles: %v", err)
+			for userID, user := range staleMap {
+				profilesMap[userID] = user
+			}
+		} else {
+			for _, user := range res.GetProfiles() {
+				profilesMap[user.GetUserID()] = user
+				c.lru.set(user)
+			}
+			if c.redisClient != nil {
+				c.saveToRedis(ctx, res.GetProfiles())
+			}
+		}
+	}
+
+	profiles := make([]*userService.PublicProfile, 0, len(profilesMap))
+	missingIDs := make([]string, 0)
+	for _, userID := range in.GetUsersIDs() {
+		if user, ok := profilesMap[userID]; ok {
+			profiles = append(profiles, user)
+			continue
+		}
+		missingIDs = append(missingIDs, userID)
+	}
+
+	return &userService.GetPublicProfilesRes{Profiles: profiles, MissingIDs: missingIDs}, nil
+}
+
+// InvalidateUser drop user from cache on user_updated event
+func (c *CachedUserClient) InvalidateUser(ctx context.Context, delivery amqp.Delivery) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CachedUserClient.InvalidateUser")
+	defer span.Finish()
+
+	env, err := events.Unmarshal(delivery.Body, events.UserUpdatedType)
+	if err != nil {
+		return errors.Wrap(err, "InvalidateUser.events.Unmarshal")
+	}
+	userID := env.GetUserUpdated().GetUserID()
+
+	c.lru.delete(userID)
+	if c.redisClient != nil {
+		if err := c.redisClient.Del(ctx, c.createKey(userID)).Err(); err != nil {
+			return errors.Wrap(err, "redisClient.Del")
+		}
+	}
+
+	c.logger.Infof("InvalidateUser: %s", userID)
+	return nil
+}
+
+// getFromRedis put found users into profilesMap and lru, returns ids not found in redis
+func (c *CachedUserClient) getFromRedis(ctx context.Context, userIDs []string, profilesMap map[string]*userService.PublicProfile) []string {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, c.createKey(userID))
+	}
+
+	values, err := c.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		c.logger.Errorf("redisClient.MGet: %v", err)
+		return userIDs
+	}
+
+	missing := make([]string, 0, len(userIDs))
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			missing = append(missing, userIDs[i])
+			continue
+		}
+
+		user := &userService.PublicProfile{}
+		if err := proto.Unmarshal([]byte(data), user); err != nil {
+			c.logger.Errorf("proto.Unmarshal: %v", err)
+			missing = append(missing, userIDs[i])
+			continue
+		}
+		profilesMap[userIDs[i]] = user
+		c.lru.set(user)
+	}
+
+	return missing
+}
+
+func (c *CachedUserClient) saveToRedis(ctx context.Context, users []*userService.PublicProfile) {
+	pipe := c.redisClient.Pipeline()
+	for _, user := range users {
+		data, err := proto.Marshal(user)
+		if err != nil {
+			c.logger.Errorf("proto.Marshal: %v", err)
+			continue
+		}
+		pipe.SetEX(ctx, c.createKey(user.GetUserID()), data, c.cfg.UsersCache.RedisTTL*time.Second)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.logger.Errorf("pipe.Exec: %v", err)
+	}
+}
+
+func (c *CachedUserClient) createKey(userID string) string {
+	return fmt.Sprintf("%s: %s", c.cfg.UsersCache.RedisPrefix, userID)
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	userService "github.com/AleksK1NG/hotels-mocroservices/comments/proto/user"
+)
+
+// profilesLRU in-process LRU of users public profiles, expired entries are kept as last-known data until evicted or invalidated
+type profilesLRU struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	ll      *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	profile   *userService.PublicProfile
+	expiresAt time.Time
+}
+
+func newProfilesLRU(size int, ttl time.Duration) *profilesLRU {
+	return &profilesLRU{size: size, ttl: ttl, ll: list.New(), entries: make(map[string]*list.Element, size)}
+}
+
+// get returns cached profile and whether it is not expired yet
+func (l *profilesLRU) get(userID string) (*userService.PublicProfile, bool, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.entries[userID]
+	if !ok {
+		return nil, false, false
+	}
+	l.ll.MoveToFront(el)
+
+	entry := el.Value.(*lruEntry)
+	return entry.profile, time.Now().Before(entry.expiresAt), true
+}
+
+func (l *profilesLRU) set(profile *userService.PublicProfile) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expiresAt := time.Now().Add(l.ttl)
+	if el, ok := l.entries[profile.GetUserID()]; ok {
+		el.Value = &lruEntry{profile: profile, expiresAt: expiresAt}
+		l.ll.MoveToFront(el)
+		return
+	}
+
+	l.entries[profile.GetUserID()] = l.ll.PushFront(&lruEntry{profile: profile, expiresAt: expiresAt})
+	for l.ll.Len() > l.size {
+		oldest := l.ll.Back()
+		l.ll.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).profile.GetUserID())
+	}
+}
+
+func (l *profilesLRU) delete(userID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.entries[userID]; ok {
+		l.ll.Remove(el)
+		delete(l.entries, userID)
+	}
+}
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS comments_hotel_id_created_at_idx;
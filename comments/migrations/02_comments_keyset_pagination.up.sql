@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS comments_hotel_id_created_at_idx ON comments (hotel_id, created_at, comment_id);
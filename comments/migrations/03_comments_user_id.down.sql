@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS comments_user_id_created_at_idx;
+DROP INDEX IF EXISTS comments_hotel_id_rating_idx;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS comments_user_id_created_at_idx ON comments (user_id, created_at, comment_id);
+CREATE INDEX IF NOT EXISTS comments_hotel_id_rating_idx ON comments (hotel_id, rating);
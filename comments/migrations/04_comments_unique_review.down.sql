@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS comments_hotel_id_user_id_uniq_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS is_duplicate;
@@ -0,0 +1,14 @@
+-- one review per user per hotel, reviews are not tied to completed stays because there is no booking data
+-- reviews written before the rule keep existing, every review after the first one of a user per hotel
+-- is marked as duplicate and left out of the unique index, cleanup of duplicates is done by admins
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS is_duplicate BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE comments c
+SET is_duplicate = true
+WHERE EXISTS(SELECT 1
+             FROM comments d
+             WHERE d.hotel_id = c.hotel_id
+               AND d.user_id = c.user_id
+               AND (d.created_at, d.comment_id) < (c.created_at, c.comment_id));
+
+CREATE UNIQUE INDEX IF NOT EXISTS comments_hotel_id_user_id_uniq_idx ON comments (hotel_id, user_id) WHERE NOT is_duplicate;
@@ -0,0 +1,8 @@
+-- replies become top level comments, keep them out of the unique review index instead of deleting them
+UPDATE comments SET is_duplicate = true WHERE parent_comment_id IS NOT NULL;
+
+DROP INDEX IF EXISTS comments_parent_comment_id_uniq_idx;
+DROP INDEX IF EXISTS comments_hotel_id_user_id_uniq_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS comments_hotel_id_user_id_uniq_idx ON comments (hotel_id, user_id) WHERE NOT is_duplicate;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_comment_id;
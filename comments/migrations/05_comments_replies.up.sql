@@ -0,0 +1,6 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS parent_comment_id UUID REFERENCES comments (comment_id) ON DELETE CASCADE;
+
+-- one review per user per hotel applies to top level comments only, every review gets at most one reply
+DROP INDEX IF EXISTS comments_hotel_id_user_id_uniq_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS comments_hotel_id_user_id_uniq_idx ON comments (hotel_id, user_id) WHERE parent_comment_id IS NULL AND NOT is_duplicate;
+CREATE UNIQUE INDEX IF NOT EXISTS comments_parent_comment_id_uniq_idx ON comments (parent_comment_id) WHERE parent_comment_id IS NOT NULL;
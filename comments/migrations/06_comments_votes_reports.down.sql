@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS comments_hidden_idx;
+DROP INDEX IF EXISTS comments_hotel_id_helpful_count_idx;
+
+DROP TABLE IF EXISTS comment_reports;
+DROP TABLE IF EXISTS comment_helpful_votes;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS review_pending;
+ALTER TABLE comments DROP COLUMN IF EXISTS hidden;
+ALTER TABLE comments DROP COLUMN IF EXISTS reports_count;
+ALTER TABLE comments DROP COLUMN IF EXISTS helpful_count;
@@ -0,0 +1,29 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS helpful_count INTEGER NOT NULL DEFAULT 0 CHECK (helpful_count >= 0);
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS reports_count INTEGER NOT NULL DEFAULT 0 CHECK (reports_count >= 0);
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS review_pending BOOLEAN NOT NULL DEFAULT false;
+
+-- duplicate reviews kept by unique review migration stay visible and are queued for moderator review
+UPDATE comments SET review_pending = true WHERE is_duplicate;
+
+-- one helpful vote per user per comment
+CREATE TABLE IF NOT EXISTS comment_helpful_votes
+(
+    comment_id UUID NOT NULL REFERENCES comments (comment_id) ON DELETE CASCADE,
+    user_id    UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+-- one abuse report per user per comment
+CREATE TABLE IF NOT EXISTS comment_reports
+(
+    comment_id UUID   NOT NULL REFERENCES comments (comment_id) ON DELETE CASCADE,
+    user_id    UUID   NOT NULL,
+    reason     citext NOT NULL CHECK ( reason <> '' ),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS comments_hotel_id_helpful_count_idx ON comments (hotel_id, helpful_count DESC, created_at DESC, comment_id DESC);
+CREATE INDEX IF NOT EXISTS comments_hidden_idx ON comments (created_at, comment_id) WHERE hidden OR review_pending;
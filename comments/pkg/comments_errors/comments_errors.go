@@ -0,0 +1,13 @@
+package comments_errors
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidSort       = errors.New("Invalid sort")
+	ErrInvalidCursorSort = errors.New("Cursor pagination supports only newest and oldest sort")
+	ErrInvalidPhotoURL   = errors.New("Photo url is not from images bucket")
+	ErrTooManyPhotos     = errors.New("Too many comment photos")
+	ErrNestedReply       = errors.New("Replies can be posted only to top level comments")
+	ErrNotReply          = errors.New("Comment is not a reply")
+	ErrOwnCommentVote    = errors.New("Users can not vote for or report their own comments")
+)
@@ -17,6 +17,12 @@ var (
 	ErrNoCtxMetaData    = errors.New("No ctx metadata")
 	ErrInvalidSessionId = errors.New("Invalid session id")
 	ErrEmailExists      = errors.New("Email already exists")
+	ErrInvalidCursor    = errors.New("Invalid cursor")
+	ErrPermissionDenied = errors.New("Permission denied")
+	ErrCommentExists    = errors.New("User already reviewed this hotel")
+	ErrReplyExists      = errors.New("Comment already has a reply")
+	ErrVoteExists       = errors.New("User already voted for this comment")
+	ErrReportExists     = errors.New("User already reported this comment")
 )
 
 // Parse error and get code
@@ -34,6 +40,18 @@ func ParseGRPCErrStatusCode(err error) codes.Code {
 		return codes.Unauthenticated
 	case errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
+	case errors.Is(err, ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, ErrCommentExists):
+		return codes.AlreadyExists
+	case errors.Is(err, ErrReplyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, ErrVoteExists):
+		return codes.AlreadyExists
+	case errors.Is(err, ErrReportExists):
+		return codes.AlreadyExists
+	case errors.Is(err, ErrInvalidCursor):
+		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "Validate"):
 		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "redis"):
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Cursor keyset pagination position, last seen (created_at, id) pair
+type Cursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// EncodeCursor encode position to opaque url safe string
+func EncodeCursor(createdAt time.Time, id uuid.UUID) string {
+	data, err := json.Marshal(&Cursor{CreatedAt: createdAt, ID: id})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decode opaque cursor string
+func DecodeCursor(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "base64.DecodeString")
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/metadata"
+
+	grpcErrors "github.com/AleksK1NG/hotels-mocroservices/comments/pkg/grpc_errors"
+)
+
+const (
+	// UserIDMetadataKey gRPC metadata key with id of the user who made the request
+	UserIDMetadataKey = "user_id"
+)
+
+// GetUserIDFromCtx get request user id from incoming gRPC metadata
+func GetUserIDFromCtx(ctx context.Context) (uuid.UUID, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return uuid.Nil, grpcErrors.ErrNoCtxMetaData
+	}
+
+	values := md.Get(UserIDMetadataKey)
+	if len(values) == 0 {
+		return uuid.Nil, grpcErrors.ErrNoCtxMetaData
+	}
+
+	return uuid.FromString(values[0])
+}
@@ -14,9 +14,10 @@ const (
 
 // Pagination query params
 type Pagination struct {
-	Size    int    `json:"size,omitempty"`
-	Page    int    `json:"page,omitempty"`
-	OrderBy string `json:"orderBy,omitempty"`
+	Size    int     `json:"size,omitempty"`
+	Page    int     `json:"page,omitempty"`
+	OrderBy string  `json:"orderBy,omitempty"`
+	Cursor  *Cursor `json:"cursor,omitempty"`
 }
 
 // NewPaginationQuery
@@ -59,6 +60,21 @@ func (q *Pagination) SetOrderBy(orderByQuery string) {
 	q.OrderBy = orderByQuery
 }
 
+// Set cursor, empty cursor means first page
+func (q *Pagination) SetCursor(cursorQuery string) error {
+	if cursorQuery == "" {
+		q.Cursor = nil
+		return nil
+	}
+	cursor, err := DecodeCursor(cursorQuery)
+	if err != nil {
+		return err
+	}
+	q.Cursor = cursor
+
+	return nil
+}
+
 // Get offset
 func (q *Pagination) GetOffset() int {
 	if q.Page == 0 {
@@ -87,6 +103,11 @@ func (q *Pagination) GetSize() int {
 	return q.Size
 }
 
+// Get cursor
+func (q *Pagination) GetCursor() *Cursor {
+	return q.Cursor
+}
+
 func (q *Pagination) GetQueryString() string {
 	return fmt.Sprintf("page=%v&size=%v&orderBy=%s", q.GetPage(), q.GetSize(), q.GetOrderBy())
 }
@@ -101,6 +122,9 @@ func GetPaginationFromCtx(c echo.Context) (*Pagination, error) {
 		return nil, err
 	}
 	q.SetOrderBy(c.QueryParam("orderBy"))
+	if err := q.SetCursor(c.QueryParam("cursor")); err != nil {
+		return nil, err
+	}
 
 	return q, nil
 }
@@ -37,14 +37,17 @@ type Comment struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentID string                 `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
-	HotelID   string                 `protobuf:"bytes,2,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
-	UserID    string                 `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	Message   string                 `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
-	Photos    []string               `protobuf:"bytes,5,rep,name=Photos,proto3" json:"Photos,omitempty"`
-	Rating    float64                `protobuf:"fixed64,6,opt,name=Rating,proto3" json:"Rating,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	CommentID       string                 `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	HotelID         string                 `protobuf:"bytes,2,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
+	UserID          string                 `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Message         string                 `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	Photos          []string               `protobuf:"bytes,5,rep,name=Photos,proto3" json:"Photos,omitempty"`
+	Rating          float64                `protobuf:"fixed64,6,opt,name=Rating,proto3" json:"Rating,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	ParentCommentID string                 `protobuf:"bytes,9,opt,name=ParentCommentID,proto3" json:"ParentCommentID,omitempty"`
+	HelpfulCount    int64                  `protobuf:"varint,10,opt,name=HelpfulCount,proto3" json:"HelpfulCount,omitempty"`
+	Hidden          bool                   `protobuf:"varint,11,opt,name=Hidden,proto3" json:"Hidden,omitempty"`
 }
 
 func (x *Comment) Reset() {
@@ -135,17 +138,37 @@ func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Comment) GetParentCommentID() string {
+	if x != nil {
+		return x.ParentCommentID
+	}
+	return ""
+}
+
+func (x *Comment) GetHelpfulCount() int64 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+func (x *Comment) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// User public profile of comment author
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
-	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
-	Email     string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
-	Avatar    string `protobuf:"bytes,5,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
-	Role      string `protobuf:"bytes,6,opt,name=Role,proto3" json:"Role,omitempty"`
+	UserID      string                 `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Avatar      string                 `protobuf:"bytes,5,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	DisplayName string                 `protobuf:"bytes,7,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	MemberSince *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=MemberSince,proto3" json:"MemberSince,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -187,39 +210,25 @@ func (x *User) GetUserID() string {
 	return ""
 }
 
-func (x *User) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
-}
-
-func (x *User) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
-}
-
-func (x *User) GetEmail() string {
+func (x *User) GetAvatar() string {
 	if x != nil {
-		return x.Email
+		return x.Avatar
 	}
 	return ""
 }
 
-func (x *User) GetAvatar() string {
+func (x *User) GetDisplayName() string {
 	if x != nil {
-		return x.Avatar
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *User) GetRole() string {
+func (x *User) GetMemberSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Role
+		return x.MemberSince
 	}
-	return ""
+	return nil
 }
 
 type CommentFull struct {
@@ -227,14 +236,16 @@ type CommentFull struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentID string                 `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
-	HotelID   string                 `protobuf:"bytes,2,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
-	User      *User                  `protobuf:"bytes,3,opt,name=User,proto3" json:"User,omitempty"`
-	Message   string                 `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
-	Photos    []string               `protobuf:"bytes,5,rep,name=Photos,proto3" json:"Photos,omitempty"`
-	Rating    float64                `protobuf:"fixed64,6,opt,name=Rating,proto3" json:"Rating,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	CommentID    string                 `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	HotelID      string                 `protobuf:"bytes,2,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
+	User         *User                  `protobuf:"bytes,3,opt,name=User,proto3" json:"User,omitempty"`
+	Message      string                 `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	Photos       []string               `protobuf:"bytes,5,rep,name=Photos,proto3" json:"Photos,omitempty"`
+	Rating       float64                `protobuf:"fixed64,6,opt,name=Rating,proto3" json:"Rating,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Replies      []*CommentFull         `protobuf:"bytes,9,rep,name=Replies,proto3" json:"Replies,omitempty"`
+	HelpfulCount int64                  `protobuf:"varint,10,opt,name=HelpfulCount,proto3" json:"HelpfulCount,omitempty"`
 }
 
 func (x *CommentFull) Reset() {
@@ -325,13 +336,27 @@ func (x *CommentFull) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CommentFull) GetReplies() []*CommentFull {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentFull) GetHelpfulCount() int64 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+// CreateCommentReq author of comment is user_id from request metadata
 type CreateCommentReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	HotelID string   `protobuf:"bytes,1,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
-	UserID  string   `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
 	Message string   `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
 	Photos  []string `protobuf:"bytes,4,rep,name=Photos,proto3" json:"Photos,omitempty"`
 	Rating  float64  `protobuf:"fixed64,5,opt,name=Rating,proto3" json:"Rating,omitempty"`
@@ -376,13 +401,6 @@ func (x *CreateCommentReq) GetHotelID() string {
 	return ""
 }
 
-func (x *CreateCommentReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
 func (x *CreateCommentReq) GetMessage() string {
 	if x != nil {
 		return x.Message
@@ -663,18 +681,17 @@ func (x *UpdateCommRes) GetComment() *Comment {
 	return nil
 }
 
-type GetByHotelReq struct {
+type CreateReplyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HotelID string `protobuf:"bytes,1,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
-	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Size    int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ParentCommentID string `protobuf:"bytes,1,opt,name=ParentCommentID,proto3" json:"ParentCommentID,omitempty"`
+	Message         string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
 }
 
-func (x *GetByHotelReq) Reset() {
-	*x = GetByHotelReq{}
+func (x *CreateReplyReq) Reset() {
+	*x = CreateReplyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_comments_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,13 +699,13 @@ func (x *GetByHotelReq) Reset() {
 	}
 }
 
-func (x *GetByHotelReq) String() string {
+func (x *CreateReplyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByHotelReq) ProtoMessage() {}
+func (*CreateReplyReq) ProtoMessage() {}
 
-func (x *GetByHotelReq) ProtoReflect() protoreflect.Message {
+func (x *CreateReplyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_comments_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,47 +717,35 @@ func (x *GetByHotelReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByHotelReq.ProtoReflect.Descriptor instead.
-func (*GetByHotelReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReplyReq.ProtoReflect.Descriptor instead.
+func (*CreateReplyReq) Descriptor() ([]byte, []int) {
 	return file_comments_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetByHotelReq) GetHotelID() string {
+func (x *CreateReplyReq) GetParentCommentID() string {
 	if x != nil {
-		return x.HotelID
+		return x.ParentCommentID
 	}
 	return ""
 }
 
-func (x *GetByHotelReq) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetByHotelReq) GetSize() int64 {
+func (x *CreateReplyReq) GetMessage() string {
 	if x != nil {
-		return x.Size
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetByHotelRes struct {
+type CreateReplyRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalCount int64          `protobuf:"varint,1,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`
-	TotalPages int64          `protobuf:"varint,2,opt,name=TotalPages,proto3" json:"TotalPages,omitempty"`
-	Page       int64          `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
-	Size       int64          `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
-	HasMore    bool           `protobuf:"varint,5,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
-	Comments   []*CommentFull `protobuf:"bytes,6,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	Comment *Comment `protobuf:"bytes,1,opt,name=Comment,proto3" json:"Comment,omitempty"`
 }
 
-func (x *GetByHotelRes) Reset() {
-	*x = GetByHotelRes{}
+func (x *CreateReplyRes) Reset() {
+	*x = CreateReplyRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_comments_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,13 +753,13 @@ func (x *GetByHotelRes) Reset() {
 	}
 }
 
-func (x *GetByHotelRes) String() string {
+func (x *CreateReplyRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByHotelRes) ProtoMessage() {}
+func (*CreateReplyRes) ProtoMessage() {}
 
-func (x *GetByHotelRes) ProtoReflect() protoreflect.Message {
+func (x *CreateReplyRes) ProtoReflect() protoreflect.Message {
 	mi := &file_comments_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,258 +771,1716 @@ func (x *GetByHotelRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByHotelRes.ProtoReflect.Descriptor instead.
-func (*GetByHotelRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReplyRes.ProtoReflect.Descriptor instead.
+func (*CreateReplyRes) Descriptor() ([]byte, []int) {
 	return file_comments_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetByHotelRes) GetTotalCount() int64 {
+func (x *CreateReplyRes) GetComment() *Comment {
 	if x != nil {
-		return x.TotalCount
+		return x.Comment
 	}
-	return 0
+	return nil
 }
 
-func (x *GetByHotelRes) GetTotalPages() int64 {
-	if x != nil {
-		return x.TotalPages
+type UpdateReplyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *UpdateReplyReq) Reset() {
+	*x = UpdateReplyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetByHotelRes) GetPage() int64 {
-	if x != nil {
-		return x.Page
+func (x *UpdateReplyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReplyReq) ProtoMessage() {}
+
+func (x *UpdateReplyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetByHotelRes) GetSize() int64 {
+// Deprecated: Use UpdateReplyReq.ProtoReflect.Descriptor instead.
+func (*UpdateReplyReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateReplyReq) GetCommentID() string {
 	if x != nil {
-		return x.Size
+		return x.CommentID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetByHotelRes) GetHasMore() bool {
+func (x *UpdateReplyReq) GetMessage() string {
 	if x != nil {
-		return x.HasMore
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *GetByHotelRes) GetComments() []*CommentFull {
+type UpdateReplyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=Comment,proto3" json:"Comment,omitempty"`
+}
+
+func (x *UpdateReplyRes) Reset() {
+	*x = UpdateReplyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReplyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReplyRes) ProtoMessage() {}
+
+func (x *UpdateReplyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReplyRes.ProtoReflect.Descriptor instead.
+func (*UpdateReplyRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateReplyRes) GetComment() *Comment {
 	if x != nil {
-		return x.Comments
+		return x.Comment
 	}
 	return nil
 }
 
-var File_comments_proto protoreflect.FileDescriptor
+type DeleteCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_comments_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x97, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48,
-	0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9a, 0x01, 0x0a,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49,
-	0x44, 0x12, 0x29, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x18, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x10, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12,
-	0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x22, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x0d, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x22, 0x43, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x65,
-	0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x38,
-	0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x08,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xe2, 0x02, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x22, 0x00, 0x42, 0x13, 0x5a,
-	0x11, 0x2e, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
 }
 
-var (
-	file_comments_proto_rawDescOnce sync.Once
-	file_comments_proto_rawDescData = file_comments_proto_rawDesc
-)
+func (x *DeleteCommentReq) Reset() {
+	*x = DeleteCommentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_comments_proto_rawDescGZIP() []byte {
-	file_comments_proto_rawDescOnce.Do(func() {
-		file_comments_proto_rawDescData = protoimpl.X.CompressGZIP(file_comments_proto_rawDescData)
-	})
-	return file_comments_proto_rawDescData
+func (x *DeleteCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_comments_proto_goTypes = []interface{}{
-	(*Comment)(nil),               // 0: commentsService.Comment
-	(*User)(nil),                  // 1: commentsService.User
-	(*CommentFull)(nil),           // 2: commentsService.CommentFull
-	(*CreateCommentReq)(nil),      // 3: commentsService.CreateCommentReq
-	(*CreateCommentRes)(nil),      // 4: commentsService.CreateCommentRes
-	(*GetCommByIDReq)(nil),        // 5: commentsService.GetCommByIDReq
-	(*GetCommByIDRes)(nil),        // 6: commentsService.GetCommByIDRes
-	(*UpdateCommReq)(nil),         // 7: commentsService.UpdateCommReq
-	(*UpdateCommRes)(nil),         // 8: commentsService.UpdateCommRes
-	(*GetByHotelReq)(nil),         // 9: commentsService.GetByHotelReq
-	(*GetByHotelRes)(nil),         // 10: commentsService.GetByHotelRes
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+func (*DeleteCommentReq) ProtoMessage() {}
+
+func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_comments_proto_depIdxs = []int32{
-	11, // 0: commentsService.Comment.CreatedAt:type_name -> google.protobuf.Timestamp
-	11, // 1: commentsService.Comment.UpdatedAt:type_name -> google.protobuf.Timestamp
-	1,  // 2: commentsService.CommentFull.User:type_name -> commentsService.User
-	11, // 3: commentsService.CommentFull.CreatedAt:type_name -> google.protobuf.Timestamp
-	11, // 4: commentsService.CommentFull.UpdatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 5: commentsService.CreateCommentRes.Comment:type_name -> commentsService.Comment
-	0,  // 6: commentsService.GetCommByIDRes.Comment:type_name -> commentsService.Comment
-	0,  // 7: commentsService.UpdateCommRes.Comment:type_name -> commentsService.Comment
-	2,  // 8: commentsService.GetByHotelRes.Comments:type_name -> commentsService.CommentFull
-	3,  // 9: commentsService.commentsService.CreateComment:input_type -> commentsService.CreateCommentReq
-	5,  // 10: commentsService.commentsService.GetCommByID:input_type -> commentsService.GetCommByIDReq
-	7,  // 11: commentsService.commentsService.UpdateComment:input_type -> commentsService.UpdateCommReq
-	9,  // 12: commentsService.commentsService.GetByHotelID:input_type -> commentsService.GetByHotelReq
-	4,  // 13: commentsService.commentsService.CreateComment:output_type -> commentsService.CreateCommentRes
-	6,  // 14: commentsService.commentsService.GetCommByID:output_type -> commentsService.GetCommByIDRes
-	8,  // 15: commentsService.commentsService.UpdateComment:output_type -> commentsService.UpdateCommRes
-	10, // 16: commentsService.commentsService.GetByHotelID:output_type -> commentsService.GetByHotelRes
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use DeleteCommentReq.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{13}
 }
 
-func init() { file_comments_proto_init() }
-func file_comments_proto_init() {
-	if File_comments_proto != nil {
-		return
+func (x *DeleteCommentReq) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_comments_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_comments_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
-			case 0:
-				return &v.state
+	return ""
+}
+
+type DeleteCommentRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentRes) Reset() {
+	*x = DeleteCommentRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRes) ProtoMessage() {}
+
+func (x *DeleteCommentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRes.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{14}
+}
+
+type UploadPhotoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID   string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+}
+
+func (x *UploadPhotoReq) Reset() {
+	*x = UploadPhotoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPhotoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoReq) ProtoMessage() {}
+
+func (x *UploadPhotoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoReq.ProtoReflect.Descriptor instead.
+func (*UploadPhotoReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UploadPhotoReq) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *UploadPhotoReq) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadPhotoReq) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadPhotoRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+}
+
+func (x *UploadPhotoRes) Reset() {
+	*x = UploadPhotoRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPhotoRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoRes) ProtoMessage() {}
+
+func (x *UploadPhotoRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoRes.ProtoReflect.Descriptor instead.
+func (*UploadPhotoRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UploadPhotoRes) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+type VoteHelpfulReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+}
+
+func (x *VoteHelpfulReq) Reset() {
+	*x = VoteHelpfulReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteHelpfulReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteHelpfulReq) ProtoMessage() {}
+
+func (x *VoteHelpfulReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteHelpfulReq.ProtoReflect.Descriptor instead.
+func (*VoteHelpfulReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VoteHelpfulReq) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+type VoteHelpfulRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID    string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	HelpfulCount int64  `protobuf:"varint,2,opt,name=HelpfulCount,proto3" json:"HelpfulCount,omitempty"`
+}
+
+func (x *VoteHelpfulRes) Reset() {
+	*x = VoteHelpfulRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteHelpfulRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteHelpfulRes) ProtoMessage() {}
+
+func (x *VoteHelpfulRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteHelpfulRes.ProtoReflect.Descriptor instead.
+func (*VoteHelpfulRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VoteHelpfulRes) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *VoteHelpfulRes) GetHelpfulCount() int64 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+type ReportCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *ReportCommentReq) Reset() {
+	*x = ReportCommentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCommentReq) ProtoMessage() {}
+
+func (x *ReportCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCommentReq.ProtoReflect.Descriptor instead.
+func (*ReportCommentReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReportCommentReq) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *ReportCommentReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportCommentRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+}
+
+func (x *ReportCommentRes) Reset() {
+	*x = ReportCommentRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportCommentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCommentRes) ProtoMessage() {}
+
+func (x *ReportCommentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCommentRes.ProtoReflect.Descriptor instead.
+func (*ReportCommentRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReportCommentRes) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+type GetHiddenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size   int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetHiddenReq) Reset() {
+	*x = GetHiddenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHiddenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHiddenReq) ProtoMessage() {}
+
+func (x *GetHiddenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHiddenReq.ProtoReflect.Descriptor instead.
+func (*GetHiddenReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetHiddenReq) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHiddenReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetHiddenReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetHiddenRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCount int64      `protobuf:"varint,1,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`
+	TotalPages int64      `protobuf:"varint,2,opt,name=TotalPages,proto3" json:"TotalPages,omitempty"`
+	Page       int64      `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size       int64      `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	HasMore    bool       `protobuf:"varint,5,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
+	Comments   []*Comment `protobuf:"bytes,6,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	NextCursor string     `protobuf:"bytes,7,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+}
+
+func (x *GetHiddenRes) Reset() {
+	*x = GetHiddenRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHiddenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHiddenRes) ProtoMessage() {}
+
+func (x *GetHiddenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHiddenRes.ProtoReflect.Descriptor instead.
+func (*GetHiddenRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetHiddenRes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetHiddenRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetHiddenRes) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHiddenRes) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetHiddenRes) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetHiddenRes) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetHiddenRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ReviewCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID string `protobuf:"bytes,1,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	Approve   bool   `protobuf:"varint,2,opt,name=Approve,proto3" json:"Approve,omitempty"`
+}
+
+func (x *ReviewCommentReq) Reset() {
+	*x = ReviewCommentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCommentReq) ProtoMessage() {}
+
+func (x *ReviewCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCommentReq.ProtoReflect.Descriptor instead.
+func (*ReviewCommentReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReviewCommentReq) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *ReviewCommentReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type ReviewCommentRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReviewCommentRes) Reset() {
+	*x = ReviewCommentRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewCommentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCommentRes) ProtoMessage() {}
+
+func (x *ReviewCommentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCommentRes.ProtoReflect.Descriptor instead.
+func (*ReviewCommentRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{24}
+}
+
+type GetByHotelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HotelID   string  `protobuf:"bytes,1,opt,name=HotelID,proto3" json:"HotelID,omitempty"`
+	Page      int64   `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size      int64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor    string  `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Sort      string  `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	MinRating float64 `protobuf:"fixed64,6,opt,name=minRating,proto3" json:"minRating,omitempty"`
+	MaxRating float64 `protobuf:"fixed64,7,opt,name=maxRating,proto3" json:"maxRating,omitempty"`
+}
+
+func (x *GetByHotelReq) Reset() {
+	*x = GetByHotelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByHotelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByHotelReq) ProtoMessage() {}
+
+func (x *GetByHotelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByHotelReq.ProtoReflect.Descriptor instead.
+func (*GetByHotelReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetByHotelReq) GetHotelID() string {
+	if x != nil {
+		return x.HotelID
+	}
+	return ""
+}
+
+func (x *GetByHotelReq) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetByHotelReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetByHotelReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetByHotelReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetByHotelReq) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *GetByHotelReq) GetMaxRating() float64 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+type GetByHotelRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCount int64          `protobuf:"varint,1,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`
+	TotalPages int64          `protobuf:"varint,2,opt,name=TotalPages,proto3" json:"TotalPages,omitempty"`
+	Page       int64          `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size       int64          `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	HasMore    bool           `protobuf:"varint,5,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
+	Comments   []*CommentFull `protobuf:"bytes,6,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	NextCursor string         `protobuf:"bytes,7,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+}
+
+func (x *GetByHotelRes) Reset() {
+	*x = GetByHotelRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByHotelRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByHotelRes) ProtoMessage() {}
+
+func (x *GetByHotelRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByHotelRes.ProtoReflect.Descriptor instead.
+func (*GetByHotelRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetByHotelRes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetByHotelRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetByHotelRes) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetByHotelRes) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetByHotelRes) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetByHotelRes) GetComments() []*CommentFull {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetByHotelRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetByUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Page   int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size   int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Sort   string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *GetByUserReq) Reset() {
+	*x = GetByUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByUserReq) ProtoMessage() {}
+
+func (x *GetByUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByUserReq.ProtoReflect.Descriptor instead.
+func (*GetByUserReq) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetByUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetByUserReq) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetByUserReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetByUserReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetByUserReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type GetByUserRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCount int64      `protobuf:"varint,1,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`
+	TotalPages int64      `protobuf:"varint,2,opt,name=TotalPages,proto3" json:"TotalPages,omitempty"`
+	Page       int64      `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size       int64      `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	HasMore    bool       `protobuf:"varint,5,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
+	Comments   []*Comment `protobuf:"bytes,6,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	NextCursor string     `protobuf:"bytes,7,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+}
+
+func (x *GetByUserRes) Reset() {
+	*x = GetByUserRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByUserRes) ProtoMessage() {}
+
+func (x *GetByUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByUserRes.ProtoReflect.Descriptor instead.
+func (*GetByUserRes) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetByUserRes) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetByUserRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetByUserRes) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetByUserRes) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetByUserRes) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetByUserRes) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetByUserRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_comments_proto protoreflect.FileDescriptor
+
+var file_comments_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xfd, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48,
+	0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x48, 0x65,
+	0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x22, 0xd0, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x06, 0x10, 0x07, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x08,
+	0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x04, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x8a, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x29, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x7c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0x46, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x77,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x43, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x28,
+	0x0a, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x22, 0x64,
+	0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x70,
+	0x66, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x70,
+	0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x48, 0x65, 0x6c, 0x70,
+	0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xe6, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x4a, 0x0a,
+	0x10, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x22, 0xb9, 0x01,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x38, 0x0a,
+	0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x08, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x48, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x48,
+	0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x32, 0xd5, 0x08, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x57, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x49,
+	0x44, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75,
+	0x6c, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0d, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x2e, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_comments_proto_rawDescOnce sync.Once
+	file_comments_proto_rawDescData = file_comments_proto_rawDesc
+)
+
+func file_comments_proto_rawDescGZIP() []byte {
+	file_comments_proto_rawDescOnce.Do(func() {
+		file_comments_proto_rawDescData = protoimpl.X.CompressGZIP(file_comments_proto_rawDescData)
+	})
+	return file_comments_proto_rawDescData
+}
+
+var file_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_comments_proto_goTypes = []interface{}{
+	(*Comment)(nil),               // 0: commentsService.Comment
+	(*User)(nil),                  // 1: commentsService.User
+	(*CommentFull)(nil),           // 2: commentsService.CommentFull
+	(*CreateCommentReq)(nil),      // 3: commentsService.CreateCommentReq
+	(*CreateCommentRes)(nil),      // 4: commentsService.CreateCommentRes
+	(*GetCommByIDReq)(nil),        // 5: commentsService.GetCommByIDReq
+	(*GetCommByIDRes)(nil),        // 6: commentsService.GetCommByIDRes
+	(*UpdateCommReq)(nil),         // 7: commentsService.UpdateCommReq
+	(*UpdateCommRes)(nil),         // 8: commentsService.UpdateCommRes
+	(*CreateReplyReq)(nil),        // 9: commentsService.CreateReplyReq
+	(*CreateReplyRes)(nil),        // 10: commentsService.CreateReplyRes
+	(*UpdateReplyReq)(nil),        // 11: commentsService.UpdateReplyReq
+	(*UpdateReplyRes)(nil),        // 12: commentsService.UpdateReplyRes
+	(*DeleteCommentReq)(nil),      // 13: commentsService.DeleteCommentReq
+	(*DeleteCommentRes)(nil),      // 14: commentsService.DeleteCommentRes
+	(*UploadPhotoReq)(nil),        // 15: commentsService.UploadPhotoReq
+	(*UploadPhotoRes)(nil),        // 16: commentsService.UploadPhotoRes
+	(*VoteHelpfulReq)(nil),        // 17: commentsService.VoteHelpfulReq
+	(*VoteHelpfulRes)(nil),        // 18: commentsService.VoteHelpfulRes
+	(*ReportCommentReq)(nil),      // 19: commentsService.ReportCommentReq
+	(*ReportCommentRes)(nil),      // 20: commentsService.ReportCommentRes
+	(*GetHiddenReq)(nil),          // 21: commentsService.GetHiddenReq
+	(*GetHiddenRes)(nil),          // 22: commentsService.GetHiddenRes
+	(*ReviewCommentReq)(nil),      // 23: commentsService.ReviewCommentReq
+	(*ReviewCommentRes)(nil),      // 24: commentsService.ReviewCommentRes
+	(*GetByHotelReq)(nil),         // 25: commentsService.GetByHotelReq
+	(*GetByHotelRes)(nil),         // 26: commentsService.GetByHotelRes
+	(*GetByUserReq)(nil),          // 27: commentsService.GetByUserReq
+	(*GetByUserRes)(nil),          // 28: commentsService.GetByUserRes
+	(*timestamppb.Timestamp)(nil), // 29: google.protobuf.Timestamp
+}
+var file_comments_proto_depIdxs = []int32{
+	29, // 0: commentsService.Comment.CreatedAt:type_name -> google.protobuf.Timestamp
+	29, // 1: commentsService.Comment.UpdatedAt:type_name -> google.protobuf.Timestamp
+	29, // 2: commentsService.User.MemberSince:type_name -> google.protobuf.Timestamp
+	1,  // 3: commentsService.CommentFull.User:type_name -> commentsService.User
+	29, // 4: commentsService.CommentFull.CreatedAt:type_name -> google.protobuf.Timestamp
+	29, // 5: commentsService.CommentFull.UpdatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 6: commentsService.CommentFull.Replies:type_name -> commentsService.CommentFull
+	0,  // 7: commentsService.CreateCommentRes.Comment:type_name -> commentsService.Comment
+	0,  // 8: commentsService.GetCommByIDRes.Comment:type_name -> commentsService.Comment
+	0,  // 9: commentsService.UpdateCommRes.Comment:type_name -> commentsService.Comment
+	0,  // 10: commentsService.CreateReplyRes.Comment:type_name -> commentsService.Comment
+	0,  // 11: commentsService.UpdateReplyRes.Comment:type_name -> commentsService.Comment
+	0,  // 12: commentsService.GetHiddenRes.Comments:type_name -> commentsService.Comment
+	2,  // 13: commentsService.GetByHotelRes.Comments:type_name -> commentsService.CommentFull
+	0,  // 14: commentsService.GetByUserRes.Comments:type_name -> commentsService.Comment
+	3,  // 15: commentsService.commentsService.CreateComment:input_type -> commentsService.CreateCommentReq
+	5,  // 16: commentsService.commentsService.GetCommByID:input_type -> commentsService.GetCommByIDReq
+	7,  // 17: commentsService.commentsService.UpdateComment:input_type -> commentsService.UpdateCommReq
+	9,  // 18: commentsService.commentsService.CreateReply:input_type -> commentsService.CreateReplyReq
+	11, // 19: commentsService.commentsService.UpdateReply:input_type -> commentsService.UpdateReplyReq
+	13, // 20: commentsService.commentsService.DeleteComment:input_type -> commentsService.DeleteCommentReq
+	15, // 21: commentsService.commentsService.UploadPhoto:input_type -> commentsService.UploadPhotoReq
+	25, // 22: commentsService.commentsService.GetByHotelID:input_type -> commentsService.GetByHotelReq
+	27, // 23: commentsService.commentsService.GetByUserID:input_type -> commentsService.GetByUserReq
+	17, // 24: commentsService.commentsService.VoteHelpful:input_type -> commentsService.VoteHelpfulReq
+	19, // 25: commentsService.commentsService.ReportComment:input_type -> commentsService.ReportCommentReq
+	21, // 26: commentsService.commentsService.GetHidden:input_type -> commentsService.GetHiddenReq
+	23, // 27: commentsService.commentsService.ReviewComment:input_type -> commentsService.ReviewCommentReq
+	4,  // 28: commentsService.commentsService.CreateComment:output_type -> commentsService.CreateCommentRes
+	6,  // 29: commentsService.commentsService.GetCommByID:output_type -> commentsService.GetCommByIDRes
+	8,  // 30: commentsService.commentsService.UpdateComment:output_type -> commentsService.UpdateCommRes
+	10, // 31: commentsService.commentsService.CreateReply:output_type -> commentsService.CreateReplyRes
+	12, // 32: commentsService.commentsService.UpdateReply:output_type -> commentsService.UpdateReplyRes
+	14, // 33: commentsService.commentsService.DeleteComment:output_type -> commentsService.DeleteCommentRes
+	16, // 34: commentsService.commentsService.UploadPhoto:output_type -> commentsService.UploadPhotoRes
+	26, // 35: commentsService.commentsService.GetByHotelID:output_type -> commentsService.GetByHotelRes
+	28, // 36: commentsService.commentsService.GetByUserID:output_type -> commentsService.GetByUserRes
+	18, // 37: commentsService.commentsService.VoteHelpful:output_type -> commentsService.VoteHelpfulRes
+	20, // 38: commentsService.commentsService.ReportComment:output_type -> commentsService.ReportCommentRes
+	22, // 39: commentsService.commentsService.GetHidden:output_type -> commentsService.GetHiddenRes
+	24, // 40: commentsService.commentsService.ReviewComment:output_type -> commentsService.ReviewCommentRes
+	28, // [28:41] is the sub-list for method output_type
+	15, // [15:28] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_comments_proto_init() }
+func file_comments_proto_init() {
+	if File_comments_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_comments_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentFull); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommentRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommByIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommByIDRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCommReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCommRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReplyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReplyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPhotoReq); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -1026,8 +2489,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentFull); i {
+		file_comments_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPhotoRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1038,8 +2501,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommentReq); i {
+		file_comments_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteHelpfulReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1050,8 +2513,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommentRes); i {
+		file_comments_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteHelpfulRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1062,8 +2525,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommByIDReq); i {
+		file_comments_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportCommentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1074,8 +2537,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommByIDRes); i {
+		file_comments_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportCommentRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1086,8 +2549,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCommReq); i {
+		file_comments_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1098,8 +2561,8 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCommRes); i {
+		file_comments_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHiddenRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1110,7 +2573,31 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_comments_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewCommentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewCommentRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetByHotelReq); i {
 			case 0:
 				return &v.state
@@ -1122,7 +2609,7 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
-		file_comments_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_comments_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetByHotelRes); i {
 			case 0:
 				return &v.state
@@ -1134,6 +2621,30 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
+		file_comments_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByUserRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1141,7 +2652,7 @@ func file_comments_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_comments_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1170,7 +2681,16 @@ type CommentsServiceClient interface {
 	CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*CreateCommentRes, error)
 	GetCommByID(ctx context.Context, in *GetCommByIDReq, opts ...grpc.CallOption) (*GetCommByIDRes, error)
 	UpdateComment(ctx context.Context, in *UpdateCommReq, opts ...grpc.CallOption) (*UpdateCommRes, error)
+	CreateReply(ctx context.Context, in *CreateReplyReq, opts ...grpc.CallOption) (*CreateReplyRes, error)
+	UpdateReply(ctx context.Context, in *UpdateReplyReq, opts ...grpc.CallOption) (*UpdateReplyRes, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error)
+	UploadPhoto(ctx context.Context, in *UploadPhotoReq, opts ...grpc.CallOption) (*UploadPhotoRes, error)
 	GetByHotelID(ctx context.Context, in *GetByHotelReq, opts ...grpc.CallOption) (*GetByHotelRes, error)
+	GetByUserID(ctx context.Context, in *GetByUserReq, opts ...grpc.CallOption) (*GetByUserRes, error)
+	VoteHelpful(ctx context.Context, in *VoteHelpfulReq, opts ...grpc.CallOption) (*VoteHelpfulRes, error)
+	ReportComment(ctx context.Context, in *ReportCommentReq, opts ...grpc.CallOption) (*ReportCommentRes, error)
+	GetHidden(ctx context.Context, in *GetHiddenReq, opts ...grpc.CallOption) (*GetHiddenRes, error)
+	ReviewComment(ctx context.Context, in *ReviewCommentReq, opts ...grpc.CallOption) (*ReviewCommentRes, error)
 }
 
 type commentsServiceClient struct {
@@ -1208,6 +2728,42 @@ func (c *commentsServiceClient) UpdateComment(ctx context.Context, in *UpdateCom
 	return out, nil
 }
 
+func (c *commentsServiceClient) CreateReply(ctx context.Context, in *CreateReplyReq, opts ...grpc.CallOption) (*CreateReplyRes, error) {
+	out := new(CreateReplyRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/CreateReply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) UpdateReply(ctx context.Context, in *UpdateReplyReq, opts ...grpc.CallOption) (*UpdateReplyRes, error) {
+	out := new(UpdateReplyRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/UpdateReply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error) {
+	out := new(DeleteCommentRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) UploadPhoto(ctx context.Context, in *UploadPhotoReq, opts ...grpc.CallOption) (*UploadPhotoRes, error) {
+	out := new(UploadPhotoRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/UploadPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentsServiceClient) GetByHotelID(ctx context.Context, in *GetByHotelReq, opts ...grpc.CallOption) (*GetByHotelRes, error) {
 	out := new(GetByHotelRes)
 	err := c.cc.Invoke(ctx, "/commentsService.commentsService/GetByHotelID", in, out, opts...)
@@ -1217,12 +2773,66 @@ func (c *commentsServiceClient) GetByHotelID(ctx context.Context, in *GetByHotel
 	return out, nil
 }
 
+func (c *commentsServiceClient) GetByUserID(ctx context.Context, in *GetByUserReq, opts ...grpc.CallOption) (*GetByUserRes, error) {
+	out := new(GetByUserRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/GetByUserID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) VoteHelpful(ctx context.Context, in *VoteHelpfulReq, opts ...grpc.CallOption) (*VoteHelpfulRes, error) {
+	out := new(VoteHelpfulRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/VoteHelpful", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) ReportComment(ctx context.Context, in *ReportCommentReq, opts ...grpc.CallOption) (*ReportCommentRes, error) {
+	out := new(ReportCommentRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/ReportComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) GetHidden(ctx context.Context, in *GetHiddenReq, opts ...grpc.CallOption) (*GetHiddenRes, error) {
+	out := new(GetHiddenRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/GetHidden", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsServiceClient) ReviewComment(ctx context.Context, in *ReviewCommentReq, opts ...grpc.CallOption) (*ReviewCommentRes, error) {
+	out := new(ReviewCommentRes)
+	err := c.cc.Invoke(ctx, "/commentsService.commentsService/ReviewComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentsServiceServer is the server API for CommentsService service.
 type CommentsServiceServer interface {
 	CreateComment(context.Context, *CreateCommentReq) (*CreateCommentRes, error)
 	GetCommByID(context.Context, *GetCommByIDReq) (*GetCommByIDRes, error)
 	UpdateComment(context.Context, *UpdateCommReq) (*UpdateCommRes, error)
+	CreateReply(context.Context, *CreateReplyReq) (*CreateReplyRes, error)
+	UpdateReply(context.Context, *UpdateReplyReq) (*UpdateReplyRes, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error)
+	UploadPhoto(context.Context, *UploadPhotoReq) (*UploadPhotoRes, error)
 	GetByHotelID(context.Context, *GetByHotelReq) (*GetByHotelRes, error)
+	GetByUserID(context.Context, *GetByUserReq) (*GetByUserRes, error)
+	VoteHelpful(context.Context, *VoteHelpfulReq) (*VoteHelpfulRes, error)
+	ReportComment(context.Context, *ReportCommentReq) (*ReportCommentRes, error)
+	GetHidden(context.Context, *GetHiddenReq) (*GetHiddenRes, error)
+	ReviewComment(context.Context, *ReviewCommentReq) (*ReviewCommentRes, error)
 }
 
 // UnimplementedCommentsServiceServer can be embedded to have forward compatible implementations.
@@ -1238,9 +2848,36 @@ func (*UnimplementedCommentsServiceServer) GetCommByID(context.Context, *GetComm
 func (*UnimplementedCommentsServiceServer) UpdateComment(context.Context, *UpdateCommReq) (*UpdateCommRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
 }
+func (*UnimplementedCommentsServiceServer) CreateReply(context.Context, *CreateReplyReq) (*CreateReplyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReply not implemented")
+}
+func (*UnimplementedCommentsServiceServer) UpdateReply(context.Context, *UpdateReplyReq) (*UpdateReplyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReply not implemented")
+}
+func (*UnimplementedCommentsServiceServer) DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (*UnimplementedCommentsServiceServer) UploadPhoto(context.Context, *UploadPhotoReq) (*UploadPhotoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPhoto not implemented")
+}
 func (*UnimplementedCommentsServiceServer) GetByHotelID(context.Context, *GetByHotelReq) (*GetByHotelRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByHotelID not implemented")
 }
+func (*UnimplementedCommentsServiceServer) GetByUserID(context.Context, *GetByUserReq) (*GetByUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByUserID not implemented")
+}
+func (*UnimplementedCommentsServiceServer) VoteHelpful(context.Context, *VoteHelpfulReq) (*VoteHelpfulRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteHelpful not implemented")
+}
+func (*UnimplementedCommentsServiceServer) ReportComment(context.Context, *ReportCommentReq) (*ReportCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportComment not implemented")
+}
+func (*UnimplementedCommentsServiceServer) GetHidden(context.Context, *GetHiddenReq) (*GetHiddenRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHidden not implemented")
+}
+func (*UnimplementedCommentsServiceServer) ReviewComment(context.Context, *ReviewCommentReq) (*ReviewCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewComment not implemented")
+}
 
 func RegisterCommentsServiceServer(s *grpc.Server, srv CommentsServiceServer) {
 	s.RegisterService(&_CommentsService_serviceDesc, srv)
@@ -1300,6 +2937,78 @@ func _CommentsService_UpdateComment_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentsService_CreateReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).CreateReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/CreateReply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).CreateReply(ctx, req.(*CreateReplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_UpdateReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).UpdateReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/UpdateReply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).UpdateReply(ctx, req.(*UpdateReplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_UploadPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPhotoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).UploadPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/UploadPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).UploadPhoto(ctx, req.(*UploadPhotoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentsService_GetByHotelID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetByHotelReq)
 	if err := dec(in); err != nil {
@@ -1318,6 +3027,96 @@ func _CommentsService_GetByHotelID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentsService_GetByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).GetByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/GetByUserID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).GetByUserID(ctx, req.(*GetByUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_VoteHelpful_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteHelpfulReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).VoteHelpful(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/VoteHelpful",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).VoteHelpful(ctx, req.(*VoteHelpfulReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_ReportComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).ReportComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/ReportComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).ReportComment(ctx, req.(*ReportCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_GetHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).GetHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/GetHidden",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).GetHidden(ctx, req.(*GetHiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsService_ReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServiceServer).ReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commentsService.commentsService/ReviewComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServiceServer).ReviewComment(ctx, req.(*ReviewCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CommentsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "commentsService.commentsService",
 	HandlerType: (*CommentsServiceServer)(nil),
@@ -1334,10 +3133,46 @@ var _CommentsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateComment",
 			Handler:    _CommentsService_UpdateComment_Handler,
 		},
+		{
+			MethodName: "CreateReply",
+			Handler:    _CommentsService_CreateReply_Handler,
+		},
+		{
+			MethodName: "UpdateReply",
+			Handler:    _CommentsService_UpdateReply_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _CommentsService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "UploadPhoto",
+			Handler:    _CommentsService_UploadPhoto_Handler,
+		},
 		{
 			MethodName: "GetByHotelID",
 			Handler:    _CommentsService_GetByHotelID_Handler,
 		},
+		{
+			MethodName: "GetByUserID",
+			Handler:    _CommentsService_GetByUserID_Handler,
+		},
+		{
+			MethodName: "VoteHelpful",
+			Handler:    _CommentsService_VoteHelpful_Handler,
+		},
+		{
+			MethodName: "ReportComment",
+			Handler:    _CommentsService_ReportComment_Handler,
+		},
+		{
+			MethodName: "GetHidden",
+			Handler:    _CommentsService_GetHidden_Handler,
+		},
+		{
+			MethodName: "ReviewComment",
+			Handler:    _CommentsService_ReviewComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comments.proto",
@@ -0,0 +1,20 @@
+#!/bin/sh
+# Dead letter policies of service queues, rejected deliveries are routed to <queue>_dlq.
+# Policies apply to already declared queues, unlike x-dead-letter queue arguments which can't be added to existing queue.
+# Usage: docker-compose exec rabbitmq sh < docker/rabbitmq/policies.sh
+
+set_dead_letter_policy() {
+  rabbitmqctl set_policy --apply-to queues "$2-dead-letter" "^$2\$" \
+    "{\"dead-letter-exchange\":\"$1\",\"dead-letter-routing-key\":\"$2\"}"
+}
+
+set_dead_letter_policy users_dead_letter avatars_queue
+
+set_dead_letter_policy images_dead_letter resize_queue
+set_dead_letter_policy images_dead_letter create_queue
+set_dead_letter_policy images_dead_letter upload_hotel_image_queue
+set_dead_letter_policy images_dead_letter upload_comment_photo_queue
+
+set_dead_letter_policy hotels_dead_letter update_hotel_image
+
+set_dead_letter_policy comments_dead_letter update_comment_photos
@@ -46,14 +46,24 @@ type GRPCServer struct {
 	MaxConnectionIdle      time.Duration
 	MaxConnectionAge       time.Duration
 	SessionGrpcServicePort string
+	UserGrpcServicePort    string
 }
 
 // RabbitMQ
 type RabbitMQ struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
+	Host         string
+	Port         string
+	User         string
+	Password     string
+	DrainTimeout time.Duration
+
+	UpdateImageConsumer RabbitMQConsumer
+}
+
+// RabbitMQConsumer worker pool and prefetch config of single queue consumer
+type RabbitMQConsumer struct {
+	WorkerPoolSize int
+	PrefetchCount  int
 }
 
 // Logger config
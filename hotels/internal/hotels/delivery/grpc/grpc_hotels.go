@@ -45,6 +45,14 @@ func (h *hotelsGRPCService) CreateHotel(ctx context.Context, req *hotelsService.
 		Location:      req.Location,
 		Rating:        req.GetRating(),
 	}
+	if req.GetOwnerID() != "" {
+		ownerUUID, err := uuid.FromString(req.GetOwnerID())
+		if err != nil {
+			h.logger.Errorf("uuid.FromString: %v", err)
+			return nil, grpc_errors.ErrorResponse(err, err.Error())
+		}
+		hotel.OwnerID = &ownerUUID
+	}
 
 	if err := h.validate.StructCtx(ctx, hotel); err != nil {
 		h.logger.Errorf("validate.StructCtx: %v", err)
@@ -127,8 +135,22 @@ func (h *hotelsGRPCService) GetHotels(ctx context.Context, req *hotelsService.Ge
 	defer span.Finish()
 
 	query := utils.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
-
-	hotelsList, err := h.hotelsUC.GetHotels(ctx, query)
+	if err := query.SetCursor(req.GetCursor()); err != nil {
+		h.logger.Errorf("query.SetCursor: %v", err)
+		return nil, grpc_errors.ErrorResponse(grpc_errors.ErrInvalidCursor, err.Error())
+	}
+	query.SetOrderBy(req.GetSortBy())
+	query.SetOrder(req.GetSortOrder())
+
+	hotelsList, err := h.hotelsUC.GetHotels(ctx, query, &models.HotelsFilter{
+		Country:   req.GetCountry(),
+		City:      req.GetCity(),
+		MinRating: req.GetMinRating(),
+		MaxRating: req.GetMaxRating(),
+		HasImage:  req.GetHasImage(),
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+	})
 	if err != nil {
 		h.logger.Errorf("hotelsUC.GetHotels: %v", err)
 		return nil, grpc_errors.ErrorResponse(err, "hotelsUC.GetHotels")
@@ -141,6 +163,7 @@ func (h *hotelsGRPCService) GetHotels(ctx context.Context, req *hotelsService.Ge
 		Size:       int64(hotelsList.Size),
 		HasMore:    hotelsList.HasMore,
 		Hotels:     hotelsList.ToProto(),
+		NextCursor: hotelsList.NextCursor,
 	}, nil
 }
 
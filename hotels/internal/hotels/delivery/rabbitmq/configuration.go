@@ -22,7 +22,6 @@ const (
 	publishMandatory = false
 	publishImmediate = false
 
-	prefetchCount  = 1
 	prefetchSize   = 0
 	prefetchGlobal = false
 
@@ -33,9 +32,11 @@ const (
 
 	HotelsExchange = "hotels"
 
+	deadLetterExchange    = "hotels_dead_letter"
+	deadLetterQueueSuffix = "_dlq"
+
 	UpdateImageQueue       = "update_hotel_image"
 	UpdateImageBindingKey  = "update_hotel_image_key"
-	UpdateImageWorkers     = 5
 	UpdateImageConsumerTag = "update_hotel_image_consumer"
 )
 
@@ -52,6 +53,10 @@ var (
 		Name: "rabbitmq_hotels_error_messages_total",
 		Help: "The total number of error incoming success RabbitMQ messages",
 	})
+	busyWorkers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rabbitmq_hotels_busy_workers",
+		Help: "The number of workers processing RabbitMQ delivery per queue",
+	}, []string{"queue"})
 )
 
 // Initialize consumers
@@ -72,6 +77,9 @@ func (c *hotelsConsumer) Initialize() error {
 
 // CloseChannels close active channels
 func (c *hotelsConsumer) CloseChannels() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	for _, channel := range c.channels {
 		go func(ch *amqp.Channel) {
 			if err := ch.Close(); err != nil {
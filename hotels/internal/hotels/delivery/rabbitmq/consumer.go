@@ -3,6 +3,7 @@ package rabbitmq
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/streadway/amqp"
@@ -17,6 +18,7 @@ import (
 type Consumer struct {
 	Worker         func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery)
 	WorkerPoolSize int
+	PrefetchCount  int
 	QueueName      string
 	ConsumerTag    string
 }
@@ -29,11 +31,15 @@ type hotelsConsumer struct {
 	hotelsUC  hotels.UseCase
 	consumers []*Consumer
 	channels  []*amqp.Channel
+
+	mu              sync.Mutex
+	consumeChannels map[string]*amqp.Channel
+	workers         sync.WaitGroup
 }
 
 // NewHotelsConsumer
 func NewHotelsConsumer(logger logger.Logger, cfg *config.Config, hotelsUC hotels.UseCase) *hotelsConsumer {
-	return &hotelsConsumer{logger: logger, cfg: cfg, hotelsUC: hotelsUC}
+	return &hotelsConsumer{logger: logger, cfg: cfg, hotelsUC: hotelsUC, consumeChannels: make(map[string]*amqp.Channel)}
 }
 
 // Dial
@@ -67,6 +73,12 @@ func (c *hotelsConsumer) CreateExchangeAndQueue(exchangeName, queueName, binding
 		return nil, errors.Wrap(err, "Error ch.ExchangeDeclare")
 	}
 
+	if err := c.declareDeadLetterQueue(ch, queueName); err != nil {
+		return nil, errors.Wrap(err, "declareDeadLetterQueue")
+	}
+
+	// rejected deliveries (invalid payload, unknown event version) are routed to queueName_dlq by broker policy
+	// (make rabbitmq_policies), x-dead-letter arguments would fail with PRECONDITION_FAILED on already declared queues
 	queue, err := ch.QueueDeclare(
 		queueName,
 		queueDurable,
@@ -99,22 +111,54 @@ func (c *hotelsConsumer) CreateExchangeAndQueue(exchangeName, queueName, binding
 		return nil, errors.Wrap(err, "Error ch.QueueBind")
 	}
 
-	err = ch.Qos(
-		prefetchCount,  // prefetch count
-		prefetchSize,   // prefetch size
-		prefetchGlobal, // global
+	return ch, nil
+}
+
+// declareDeadLetterQueue declare dead letter exchange and queue for rejected deliveries of queueName
+func (c *hotelsConsumer) declareDeadLetterQueue(ch *amqp.Channel, queueName string) error {
+	c.logger.Infof("Declaring dead letter exchange: %s", deadLetterExchange)
+	if err := ch.ExchangeDeclare(
+		deadLetterExchange,
+		exchangeKind,
+		exchangeDurable,
+		exchangeAutoDelete,
+		exchangeInternal,
+		exchangeNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.ExchangeDeclare")
+	}
+
+	dlq, err := ch.QueueDeclare(
+		queueName+deadLetterQueueSuffix,
+		queueDurable,
+		queueAutoDelete,
+		queueExclusive,
+		queueNoWait,
+		nil,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error  ch.Qos")
+		return errors.Wrap(err, "Error ch.QueueDeclare")
 	}
 
-	return ch, nil
+	if err := ch.QueueBind(
+		dlq.Name,
+		queueName,
+		deadLetterExchange,
+		queueNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.QueueBind")
+	}
+
+	return nil
 }
 
 func (c *hotelsConsumer) startConsume(
 	ctx context.Context,
 	worker func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery),
 	workerPoolSize int,
+	prefetchCount int,
 	queueName string,
 	consumerTag string,
 ) error {
@@ -123,6 +167,15 @@ func (c *hotelsConsumer) startConsume(
 		return errors.Wrap(err, "c.amqpConn.Channel")
 	}
 
+	err = ch.Qos(
+		prefetchCount,  // prefetch count
+		prefetchSize,   // prefetch size
+		prefetchGlobal, // global
+	)
+	if err != nil {
+		return errors.Wrap(err, "Error  ch.Qos")
+	}
+
 	deliveries, err := ch.Consume(
 		queueName,
 		consumerTag,
@@ -136,6 +189,11 @@ func (c *hotelsConsumer) startConsume(
 		return errors.Wrap(err, "ch.Consume")
 	}
 
+	c.mu.Lock()
+	c.channels = append(c.channels, ch)
+	c.consumeChannels[consumerTag] = ch
+	c.mu.Unlock()
+
 	wg := &sync.WaitGroup{}
 
 	wg.Add(workerPoolSize)
@@ -143,6 +201,12 @@ func (c *hotelsConsumer) startConsume(
 		go worker(ctx, wg, deliveries)
 	}
 
+	c.workers.Add(1)
+	go func() {
+		defer c.workers.Done()
+		wg.Wait()
+	}()
+
 	chanErr := <-ch.NotifyClose(make(chan *amqp.Error))
 	c.logger.Errorf("ch.NotifyClose: %v", chanErr)
 
@@ -162,6 +226,7 @@ func (c *hotelsConsumer) run(ctx context.Context, cancel context.CancelFunc) {
 				ctx,
 				consumer.Worker,
 				consumer.WorkerPoolSize,
+				consumer.PrefetchCount,
 				consumer.QueueName,
 				consumer.ConsumerTag,
 			); err != nil {
@@ -175,9 +240,34 @@ func (c *hotelsConsumer) run(ctx context.Context, cancel context.CancelFunc) {
 func (c *hotelsConsumer) RunConsumers(ctx context.Context, cancel context.CancelFunc) {
 	c.AddConsumer(&Consumer{
 		Worker:         c.updateImageWorker,
-		WorkerPoolSize: UpdateImageWorkers,
+		WorkerPoolSize: c.cfg.RabbitMQ.UpdateImageConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.UpdateImageConsumer.PrefetchCount,
 		QueueName:      UpdateImageQueue,
 		ConsumerTag:    UpdateImageConsumerTag,
 	})
 	c.run(ctx, cancel)
 }
+
+// Drain cancel consumers and wait until workers process and ack in-flight deliveries, must be called before CloseChannels
+func (c *hotelsConsumer) Drain(timeout time.Duration) {
+	c.mu.Lock()
+	for consumerTag, ch := range c.consumeChannels {
+		if err := ch.Cancel(consumerTag, consumeNoWait); err != nil {
+			c.logger.Errorf("Drain ch.Cancel: %v", err)
+		}
+	}
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("Consumers drained")
+	case <-time.After(timeout):
+		c.logger.Errorf("Consumers drain timeout: %v", timeout)
+	}
+}
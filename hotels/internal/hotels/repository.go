@@ -15,5 +15,5 @@ type PGRepository interface {
 	UpdateHotel(ctx context.Context, hotel *models.Hotel) (*models.Hotel, error)
 	UpdateHotelImage(ctx context.Context, hotelID uuid.UUID, imageURL string) error
 	GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error)
-	GetHotels(ctx context.Context, query *utils.PaginationQuery) (*models.HotelsList, error)
+	GetHotels(ctx context.Context, query *utils.PaginationQuery, filter *models.HotelsFilter) (*models.HotelsList, error)
 }
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -42,6 +44,7 @@ func (h *hotelsPGRepository) CreateHotel(ctx context.Context, hotel *models.Hote
 		hotel.Country,
 		hotel.City,
 		hotel.Rating,
+		hotel.OwnerID,
 	).Scan(&res.HotelID, &res.CreatedAt, &res.UpdatedAt); err != nil {
 		return nil, errors.Wrap(err, "CreateHotel.Scan")
 	}
@@ -87,6 +90,7 @@ func (h *hotelsPGRepository) UpdateHotel(ctx context.Context, hotel *models.Hote
 		&res.Image,
 		&res.CreatedAt,
 		&res.UpdatedAt,
+		&res.OwnerID,
 	); err != nil {
 		return nil, errors.Wrap(err, "db.QueryRow.Scan")
 	}
@@ -116,6 +120,7 @@ func (h *hotelsPGRepository) GetHotelByID(ctx context.Context, hotelID uuid.UUID
 		&hotel.Image,
 		&hotel.CreatedAt,
 		&hotel.UpdatedAt,
+		&hotel.OwnerID,
 	); err != nil {
 		return nil, errors.Wrap(err, "db.QueryRow.Scan")
 	}
@@ -124,12 +129,22 @@ func (h *hotelsPGRepository) GetHotelByID(ctx context.Context, hotelID uuid.UUID
 }
 
 // GetHotels
-func (h *hotelsPGRepository) GetHotels(ctx context.Context, query *utils.PaginationQuery) (*models.HotelsList, error) {
+func (h *hotelsPGRepository) GetHotels(ctx context.Context, query *utils.PaginationQuery, filter *models.HotelsFilter) (*models.HotelsList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsPGRepository.GetHotels")
 	defer span.Finish()
 
+	where, args := buildHotelsFilter(filter)
+	orderBy, args, err := buildHotelsOrderBy(query, filter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.GetCursor() != nil {
+		return h.getHotelsByCursor(ctx, query, where, orderBy, args)
+	}
+
 	var total int
-	if err := h.db.QueryRow(ctx, getTotalHotelsCountQuery).Scan(&total); err != nil {
+	if err := h.db.QueryRow(ctx, getTotalHotelsCountQuery+where, args...).Scan(&total); err != nil {
 		return nil, errors.Wrap(err, "db.Query")
 	}
 	if total == 0 {
@@ -143,13 +158,99 @@ func (h *hotelsPGRepository) GetHotels(ctx context.Context, query *utils.Paginat
 		}, nil
 	}
 
-	rows, err := h.db.Query(ctx, getHotelsQuery, query.GetOffset(), query.GetLimit())
+	args = append(args, query.GetOffset(), query.GetLimit())
+	hotelsQuery := fmt.Sprintf("%s%s%s OFFSET $%d LIMIT $%d", getHotelsQuery, where, orderBy, len(args)-1, len(args))
+
+	rows, err := h.db.Query(ctx, hotelsQuery, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "db.Query")
 	}
 	defer rows.Close()
 
-	hotels := make([]*models.Hotel, 0, query.GetLimit())
+	hotels, err := h.scanHotels(rows, query.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("HOTELS: %-v", hotels)
+
+	hasMore := query.GetHasMore(total)
+	var nextCursor string
+	if hasMore && len(hotels) > 0 && isCreatedAtSort(query) {
+		last := hotels[len(hotels)-1]
+		nextCursor = utils.EncodeCursor(*last.CreatedAt, last.HotelID)
+	}
+
+	return &models.HotelsList{
+		TotalCount: total,
+		TotalPages: query.GetTotalPages(total),
+		Page:       query.GetPage(),
+		Size:       query.GetSize(),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+		Hotels:     hotels,
+	}, nil
+}
+
+// getHotelsByCursor keyset pagination by (created_at, hotel_id), fetch one extra row instead of total count
+func (h *hotelsPGRepository) getHotelsByCursor(
+	ctx context.Context,
+	query *utils.PaginationQuery,
+	where string,
+	orderBy string,
+	args []interface{},
+) (*models.HotelsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsPGRepository.getHotelsByCursor")
+	defer span.Finish()
+
+	if !isCreatedAtSort(query) {
+		return nil, errors.Wrap(hotels_errors.ErrInvalidCursorSort, "Validate cursor")
+	}
+
+	cursor := query.GetCursor()
+	args = append(args, cursor.CreatedAt, cursor.ID)
+	keyset := fmt.Sprintf("(created_at, hotel_id) > ($%d, $%d)", len(args)-1, len(args))
+	if query.IsDesc() {
+		keyset = fmt.Sprintf("(created_at, hotel_id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+	if where == "" {
+		where = " WHERE " + keyset
+	} else {
+		where += " AND " + keyset
+	}
+
+	args = append(args, query.GetLimit()+1)
+	hotelsQuery := fmt.Sprintf("%s%s%s LIMIT $%d", getHotelsQuery, where, orderBy, len(args))
+
+	rows, err := h.db.Query(ctx, hotelsQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	hotels, err := h.scanHotels(rows, query.GetLimit()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(hotels) > query.GetLimit()
+	var nextCursor string
+	if hasMore {
+		hotels = hotels[:query.GetLimit()]
+		last := hotels[len(hotels)-1]
+		nextCursor = utils.EncodeCursor(*last.CreatedAt, last.HotelID)
+	}
+
+	return &models.HotelsList{
+		Size:       query.GetSize(),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+		Hotels:     hotels,
+	}, nil
+}
+
+func (h *hotelsPGRepository) scanHotels(rows pgx.Rows, size int) ([]*models.Hotel, error) {
+	hotels := make([]*models.Hotel, 0, size)
 	for rows.Next() {
 		var hotel models.Hotel
 		if err := rows.Scan(
@@ -168,6 +269,7 @@ func (h *hotelsPGRepository) GetHotels(ctx context.Context, query *utils.Paginat
 			&hotel.Image,
 			&hotel.CreatedAt,
 			&hotel.UpdatedAt,
+			&hotel.OwnerID,
 		); err != nil {
 			return nil, errors.Wrap(err, "rows.Scan")
 		}
@@ -178,16 +280,7 @@ func (h *hotelsPGRepository) GetHotels(ctx context.Context, query *utils.Paginat
 		return nil, errors.Wrap(err, "rows.Err")
 	}
 
-	log.Printf("HOTELS: %-v", hotels)
-
-	return &models.HotelsList{
-		TotalCount: total,
-		TotalPages: query.GetTotalPages(total),
-		Page:       query.GetPage(),
-		Size:       query.GetSize(),
-		HasMore:    query.GetHasMore(total),
-		Hotels:     hotels,
-	}, nil
+	return hotels, nil
 }
 
 // UpdateHotelImage
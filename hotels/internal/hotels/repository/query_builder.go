@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/hotels_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/utils"
+)
+
+const (
+	sortByCreatedAt = "created_at"
+	sortByDistance  = "distance"
+
+	sortOrderAsc  = "asc"
+	sortOrderDesc = "desc"
+)
+
+// hotelsSortColumns whitelist of hotels list sort fields, distance is ordered by coordinates from request point
+var hotelsSortColumns = map[string]string{
+	"rating":         "rating",
+	"name":           "name",
+	"created_at":     "created_at",
+	"comments_count": "comments_count",
+	"distance":       "coordinates",
+}
+
+// buildHotelsFilter build WHERE clause with positional args
+func buildHotelsFilter(filter *models.HotelsFilter) (string, []interface{}) {
+	if filter == nil {
+		return "", nil
+	}
+
+	conditions := make([]string, 0, 5)
+	args := make([]interface{}, 0, 5)
+
+	if filter.Country != "" {
+		args = append(args, filter.Country)
+		conditions = append(conditions, fmt.Sprintf("country = $%d", len(args)))
+	}
+	if filter.City != "" {
+		args = append(args, filter.City)
+		conditions = append(conditions, fmt.Sprintf("city = $%d", len(args)))
+	}
+	if filter.MinRating > 0 {
+		args = append(args, filter.MinRating)
+		conditions = append(conditions, fmt.Sprintf("rating >= $%d", len(args)))
+	}
+	if filter.MaxRating > 0 {
+		args = append(args, filter.MaxRating)
+		conditions = append(conditions, fmt.Sprintf("rating <= $%d", len(args)))
+	}
+	if filter.HasImage {
+		conditions = append(conditions, "image IS NOT NULL AND image <> ''")
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// buildHotelsOrderBy validate sort field and order against whitelist and build ORDER BY clause
+func buildHotelsOrderBy(query *utils.PaginationQuery, filter *models.HotelsFilter, args []interface{}) (string, []interface{}, error) {
+	sortBy := query.GetOrderBy()
+	if sortBy == "" {
+		sortBy = sortByCreatedAt
+	}
+
+	column, ok := hotelsSortColumns[sortBy]
+	if !ok {
+		return "", nil, errors.Wrapf(hotels_errors.ErrInvalidSortField, "Validate orderBy: %s", sortBy)
+	}
+
+	var direction string
+	switch strings.ToLower(query.GetOrder()) {
+	case "", sortOrderAsc:
+		direction = "ASC"
+	case sortOrderDesc:
+		direction = "DESC"
+	default:
+		return "", nil, errors.Wrapf(hotels_errors.ErrInvalidSortOrder, "Validate order: %s", query.GetOrder())
+	}
+
+	if sortBy == sortByDistance {
+		if filter == nil || (filter.Latitude == 0 && filter.Longitude == 0) {
+			return "", nil, errors.Wrap(hotels_errors.ErrInvalidSortField, "Validate distance sort requires latitude and longitude")
+		}
+		args = append(args, utils.GeneratePointToGeoFromFloat64(filter.Latitude, filter.Longitude))
+		column = fmt.Sprintf("coordinates <-> ST_GeomFromEWKT($%d)", len(args))
+	}
+
+	return fmt.Sprintf(" ORDER BY %s %s, hotel_id %s", column, direction, direction), args, nil
+}
+
+func isCreatedAtSort(query *utils.PaginationQuery) bool {
+	return query.GetOrderBy() == "" || query.GetOrderBy() == sortByCreatedAt
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/hotels_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/utils"
+)
+
+func TestBuildHotelsOrderBy(t *testing.T) {
+	point := &models.HotelsFilter{Latitude: 52.52, Longitude: 13.40}
+
+	tests := []struct {
+		name     string
+		sortBy   string
+		order    string
+		filter   *models.HotelsFilter
+		want     string
+		wantArgs int
+		wantErr  error
+	}{
+		{name: "default created_at asc", want: " ORDER BY created_at ASC, hotel_id ASC"},
+		{name: "rating desc", sortBy: "rating", order: "desc", want: " ORDER BY rating DESC, hotel_id DESC"},
+		{name: "order is case insensitive", sortBy: "name", order: "DESC", want: " ORDER BY name DESC, hotel_id DESC"},
+		{name: "comments count asc", sortBy: "comments_count", order: "asc", want: " ORDER BY comments_count ASC, hotel_id ASC"},
+		{
+			name:     "distance from point",
+			sortBy:   "distance",
+			filter:   point,
+			want:     " ORDER BY coordinates <-> ST_GeomFromEWKT($1) ASC, hotel_id ASC",
+			wantArgs: 1,
+		},
+		{name: "distance without point", sortBy: "distance", wantErr: hotels_errors.ErrInvalidSortField},
+		{name: "column not in whitelist", sortBy: "password", wantErr: hotels_errors.ErrInvalidSortField},
+		{name: "sql injection", sortBy: "rating; DROP TABLE hotels", wantErr: hotels_errors.ErrInvalidSortField},
+		{name: "invalid order", sortBy: "rating", order: "sideways", wantErr: hotels_errors.ErrInvalidSortOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := utils.NewPaginationQuery(10, 1)
+			query.SetOrderBy(tt.sortBy)
+			query.SetOrder(tt.order)
+
+			orderBy, args, err := buildHotelsOrderBy(query, tt.filter, nil)
+			if tt.wantErr != nil {
+				if errors.Cause(err) != tt.wantErr {
+					t.Fatalf("buildHotelsOrderBy error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildHotelsOrderBy: %v", err)
+			}
+			if orderBy != tt.want {
+				t.Errorf("orderBy = %q, want %q", orderBy, tt.want)
+			}
+			if len(args) != tt.wantArgs {
+				t.Errorf("len(args) = %d, want %d", len(args), tt.wantArgs)
+			}
+		})
+	}
+}
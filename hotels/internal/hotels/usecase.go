@@ -15,7 +15,7 @@ type UseCase interface {
 	CreateHotel(ctx context.Context, hotel *models.Hotel) (*models.Hotel, error)
 	UpdateHotel(ctx context.Context, hotel *models.Hotel) (*models.Hotel, error)
 	GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error)
-	GetHotels(ctx context.Context, query *utils.PaginationQuery) (*models.HotelsList, error)
+	GetHotels(ctx context.Context, query *utils.PaginationQuery, filter *models.HotelsFilter) (*models.HotelsList, error)
 	UploadImage(ctx context.Context, msg *models.UploadHotelImageMsg) error
 	UpdateHotelImage(ctx context.Context, delivery amqp.Delivery) error
 }
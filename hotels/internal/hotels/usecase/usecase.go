@@ -2,7 +2,6 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -12,16 +11,17 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/hotels"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/hotels/delivery/rabbitmq"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/models"
-	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/hotels_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/events"
+	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/grpc_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/logger"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/utils"
+	eventsService "github.com/AleksK1NG/hotels-mocroservices/hotels/proto/events"
+	userService "github.com/AleksK1NG/hotels-mocroservices/hotels/proto/user"
 )
 
 const (
-	hotelIDHeader = "hotel_uuid"
-
 	imagesExchange             = "images"
-	uploadHotelImageRoutingKey = "upload_hotel_image"
+	uploadHotelImageRoutingKey = "upload_hotel_image_binding_key"
 )
 
 // hotelsUC Hotels usecase
@@ -29,11 +29,17 @@ type hotelsUC struct {
 	hotelsRepo    hotels.PGRepository
 	logger        logger.Logger
 	amqpPublisher rabbitmq.Publisher
+	userClient    userService.UserServiceClient
 }
 
 // NewHotelsUC constructor
-func NewHotelsUC(hotelsRepo hotels.PGRepository, logger logger.Logger, amqpPublisher rabbitmq.Publisher) *hotelsUC {
-	return &hotelsUC{hotelsRepo: hotelsRepo, logger: logger, amqpPublisher: amqpPublisher}
+func NewHotelsUC(
+	hotelsRepo hotels.PGRepository,
+	logger logger.Logger,
+	amqpPublisher rabbitmq.Publisher,
+	userClient userService.UserServiceClient,
+) *hotelsUC {
+	return &hotelsUC{hotelsRepo: hotelsRepo, logger: logger, amqpPublisher: amqpPublisher, userClient: userClient}
 }
 
 // CreateHotel create new hotel
@@ -41,6 +47,20 @@ func (h *hotelsUC) CreateHotel(ctx context.Context, hotel *models.Hotel) (*model
 	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.CreateHotel")
 	defer span.Finish()
 
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := h.getPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission(permissions, userService.Permission_HOTEL_CREATE) {
+		h.logger.Warnf("user: %s has no permission: %v", userID.String(), userService.Permission_HOTEL_CREATE)
+		return nil, grpc_errors.ErrPermissionDenied
+	}
+
 	return h.hotelsRepo.CreateHotel(ctx, hotel)
 }
 
@@ -49,9 +69,63 @@ func (h *hotelsUC) UpdateHotel(ctx context.Context, hotel *models.Hotel) (*model
 	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.UpdateHotel")
 	defer span.Finish()
 
+	if err := h.checkHotelOwner(ctx, hotel.HotelID); err != nil {
+		return nil, err
+	}
+
 	return h.hotelsRepo.UpdateHotel(ctx, hotel)
 }
 
+// checkHotelOwner allow only hotel owner with hotel:update:own permission from request metadata user id,
+// users with hotel:update:any can change any hotel
+func (h *hotelsUC) checkHotelOwner(ctx context.Context, hotelID uuid.UUID) error {
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := h.getPermissions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if hasPermission(permissions, userService.Permission_HOTEL_UPDATE_ANY) {
+		return nil
+	}
+	if !hasPermission(permissions, userService.Permission_HOTEL_UPDATE_OWN) {
+		h.logger.Warnf("user: %s has no permission: %v", userID.String(), userService.Permission_HOTEL_UPDATE_OWN)
+		return grpc_errors.ErrPermissionDenied
+	}
+
+	hotel, err := h.hotelsRepo.GetHotelByID(ctx, hotelID)
+	if err != nil {
+		return err
+	}
+	if hotel.OwnerID == nil || !uuid.Equal(*hotel.OwnerID, userID) {
+		h.logger.Warnf("user: %s is not owner of hotel: %s", userID.String(), hotelID.String())
+		return grpc_errors.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// getPermissions permissions of user granted by user service, roles are never mapped to permissions here
+func (h *hotelsUC) getPermissions(ctx context.Context, userID uuid.UUID) ([]userService.Permission, error) {
+	userRes, err := h.userClient.GetUserByID(ctx, &userService.GetByIDRequest{UserID: userID.String()})
+	if err != nil {
+		return nil, err
+	}
+	return userRes.GetUser().GetPermissions(), nil
+}
+
+func hasPermission(permissions []userService.Permission, permission userService.Permission) bool {
+	for _, granted := range permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
 // GetHotelByID get hotel by uuid
 func (h *hotelsUC) GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.GetHotelByID")
@@ -61,11 +135,11 @@ func (h *hotelsUC) GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models
 }
 
 // GetHotels
-func (h *hotelsUC) GetHotels(ctx context.Context, query *utils.PaginationQuery) (*models.HotelsList, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.CreateHotel")
+func (h *hotelsUC) GetHotels(ctx context.Context, query *utils.PaginationQuery, filter *models.HotelsFilter) (*models.HotelsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.GetHotels")
 	defer span.Finish()
 
-	return h.hotelsRepo.GetHotels(ctx, query)
+	return h.hotelsRepo.GetHotels(ctx, query, filter)
 }
 
 // UploadImage
@@ -73,17 +147,30 @@ func (h *hotelsUC) UploadImage(ctx context.Context, msg *models.UploadHotelImage
 	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.UploadImage")
 	defer span.Finish()
 
-	headers := make(amqp.Table, 1)
-	headers[hotelIDHeader] = msg.HotelID.String()
+	if err := h.checkHotelOwner(ctx, msg.HotelID); err != nil {
+		return err
+	}
+
+	msgBytes, err := events.Marshal(ctx, &eventsService.Envelope{
+		Payload: &eventsService.Envelope_UploadHotelImage{UploadHotelImage: &eventsService.UploadHotelImage{
+			HotelID:     msg.HotelID.String(),
+			ContentType: msg.ContentType,
+			Data:        msg.Data,
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "UploadImage.events.Marshal")
+	}
+
 	if err := h.amqpPublisher.Publish(
 		ctx,
 		imagesExchange,
 		uploadHotelImageRoutingKey,
-		msg.ContentType,
-		headers,
-		msg.Data,
+		events.ContentType,
+		nil,
+		msgBytes,
 	); err != nil {
-		return errors.Wrap(err, "UpdateUploadedAvatar.Publish")
+		return errors.Wrap(err, "UploadImage.Publish")
 	}
 
 	return nil
@@ -94,34 +181,20 @@ func (h *hotelsUC) UpdateHotelImage(ctx context.Context, delivery amqp.Delivery)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "hotelsUC.UpdateHotelImage")
 	defer span.Finish()
 
-	var msg models.UpdateHotelImageMsg
-	if err := json.Unmarshal(delivery.Body, &msg); err != nil {
-		return errors.Wrap(err, "UpdateHotelImage.json.Unmarshal")
-	}
-
-	if err := h.hotelsRepo.UpdateHotelImage(ctx, msg.HotelID, msg.Image); err != nil {
-		return err
+	env, err := events.Unmarshal(delivery.Body, events.HotelImageUpdatedType)
+	if err != nil {
+		return errors.Wrap(err, "UpdateHotelImage.events.Unmarshal")
 	}
+	msg := env.GetHotelImageUpdated()
 
-	return nil
-}
-
-func (h *hotelsUC) validateDeliveryHeaders(delivery amqp.Delivery) (*uuid.UUID, error) {
-	h.logger.Infof("amqp.Delivery header: %-v", delivery.Headers)
-
-	hotelUUID, ok := delivery.Headers[hotelIDHeader]
-	if !ok {
-		return nil, hotels_errors.ErrInvalidDeliveryHeaders
-	}
-	hotelID, ok := hotelUUID.(string)
-	if !ok {
-		return nil, hotels_errors.ErrInvalidUUID
+	hotelID, err := uuid.FromString(msg.GetHotelID())
+	if err != nil {
+		return errors.Wrap(err, "uuid.FromString")
 	}
 
-	parsedUUID, err := uuid.FromString(hotelID)
-	if err != nil {
-		return nil, errors.Wrap(err, "uuid.FromString")
+	if err := h.hotelsRepo.UpdateHotelImage(ctx, hotelID, msg.GetImageURL()); err != nil {
+		return err
 	}
 
-	return &parsedUUID, nil
+	return nil
 }
@@ -26,6 +26,7 @@ type Hotel struct {
 	Longitude     *float64   `json:"longitude,omitempty"`
 	CreatedAt     *time.Time `json:"created_at"`
 	UpdatedAt     *time.Time `json:"updated_at"`
+	OwnerID       *uuid.UUID `json:"owner_id,omitempty"`
 }
 
 func (h *Hotel) GetImage() string {
@@ -52,6 +53,14 @@ func (h *Hotel) GetLongitude() float64 {
 	return lon
 }
 
+func (h *Hotel) GetOwnerID() string {
+	var ownerID string
+	if h.OwnerID != nil {
+		ownerID = h.OwnerID.String()
+	}
+	return ownerID
+}
+
 func (h *Hotel) ToProto() *hotelsService.Hotel {
 	return &hotelsService.Hotel{
 		HotelID:       h.HotelID.String(),
@@ -68,9 +77,21 @@ func (h *Hotel) ToProto() *hotelsService.Hotel {
 		Location:      h.Location,
 		CreatedAt:     timestamppb.New(*h.CreatedAt),
 		UpdatedAt:     timestamppb.New(*h.UpdatedAt),
+		OwnerID:       h.GetOwnerID(),
 	}
 }
 
+// HotelsFilter hotels list filters, zero values are not applied
+type HotelsFilter struct {
+	Country   string  `json:"country,omitempty"`
+	City      string  `json:"city,omitempty"`
+	MinRating float64 `json:"minRating,omitempty"`
+	MaxRating float64 `json:"maxRating,omitempty"`
+	HasImage  bool    `json:"hasImage,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
 // All Hotels response with pagination
 type HotelsList struct {
 	TotalCount int      `json:"totalCount"`
@@ -78,6 +99,7 @@ type HotelsList struct {
 	Page       int      `json:"page"`
 	Size       int      `json:"size"`
 	HasMore    bool     `json:"hasMore"`
+	NextCursor string   `json:"nextCursor,omitempty"`
 	Hotels     []*Hotel `json:"comments"`
 }
 
@@ -90,12 +112,6 @@ func (h *HotelsList) ToProto() []*hotelsService.Hotel {
 	return hotelsList
 }
 
-// UpdateHotelImageMsg
-type UpdateHotelImageMsg struct {
-	HotelID uuid.UUID `json:"hotel_id"`
-	Image   string    `json:"image,omitempty"`
-}
-
 // UpdateHotelImageMsg
 type UploadHotelImageMsg struct {
 	HotelID     uuid.UUID `json:"hotel_id"`
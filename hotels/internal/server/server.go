@@ -28,8 +28,10 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/hotels/delivery/rabbitmq"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/hotels/repository"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/internal/hotels/usecase"
+	userGrpc "github.com/AleksK1NG/hotels-mocroservices/hotels/internal/user/grpc"
 	"github.com/AleksK1NG/hotels-mocroservices/hotels/pkg/logger"
 	hotelsService "github.com/AleksK1NG/hotels-mocroservices/hotels/proto/hotels"
+	userService "github.com/AleksK1NG/hotels-mocroservices/hotels/proto/user"
 )
 
 // Server
@@ -58,7 +60,15 @@ func (s *Server) Run() error {
 
 	validate := validator.New()
 	hotelsPGRepo := repository.NewHotelsPGRepository(s.pgxPool)
-	hotelsUC := usecase.NewHotelsUC(hotelsPGRepo, s.logger, hp)
+
+	userGRPCConn, err := userGrpc.NewGRPCClientServiceConn(ctx, s.cfg.GRPCServer.UserGrpcServicePort)
+	if err != nil {
+		return errors.Wrap(err, "userGrpc.NewGRPCClientServiceConn")
+	}
+	defer userGRPCConn.Close()
+	userServiceClient := userService.NewUserServiceClient(userGRPCConn)
+
+	hotelsUC := usecase.NewHotelsUC(hotelsPGRepo, s.logger, hp, userServiceClient)
 
 	l, err := net.Listen("tcp", s.cfg.GRPCServer.Port)
 	if err != nil {
@@ -128,6 +138,7 @@ func (s *Server) Run() error {
 	}
 
 	server.GracefulStop()
+	hotelsConsumer.Drain(s.cfg.RabbitMQ.DrainTimeout * time.Second)
 	s.logger.Info("Server Exited Properly")
 
 	return nil
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	backoffLinear = 100 * time.Millisecond
+)
+
+// NewGRPCClientServiceConn
+func NewGRPCClientServiceConn(ctx context.Context, target string) (*grpc.ClientConn, error) {
+	opts := []grpc_retry.CallOption{
+		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(backoffLinear)),
+		grpc_retry.WithCodes(codes.NotFound, codes.Aborted),
+	}
+
+	clientGRPCConn, err := grpc.DialContext(
+		ctx,
+		target,
+		grpc.WithChainUnaryInterceptor(
+			grpc_opentracing.UnaryClientInterceptor(),
+			grpc_retry.UnaryClientInterceptor(opts...),
+		),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientGRPCConn, nil
+}
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS hotels_created_at_hotel_id_idx;
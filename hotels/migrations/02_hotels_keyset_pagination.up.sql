@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS hotels_created_at_hotel_id_idx ON hotels (created_at, hotel_id);
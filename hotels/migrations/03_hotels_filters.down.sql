@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS hotels_country_city_idx;
+DROP INDEX IF EXISTS hotels_rating_idx;
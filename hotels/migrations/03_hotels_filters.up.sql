@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS hotels_country_city_idx ON hotels (country, city);
+CREATE INDEX IF NOT EXISTS hotels_rating_idx ON hotels (rating);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS hotels_owner_id_idx;
+
+ALTER TABLE hotels DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,3 @@
+ALTER TABLE hotels ADD COLUMN IF NOT EXISTS owner_id UUID;
+
+CREATE INDEX IF NOT EXISTS hotels_owner_id_idx ON hotels (owner_id);
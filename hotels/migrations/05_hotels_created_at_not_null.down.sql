@@ -0,0 +1 @@
+ALTER TABLE hotels ALTER COLUMN created_at DROP NOT NULL;
@@ -0,0 +1,4 @@
+-- created_at is part of the pagination cursor, rows without it can't be paginated by keyset
+UPDATE hotels SET created_at = CURRENT_TIMESTAMP WHERE created_at IS NULL;
+
+ALTER TABLE hotels ALTER COLUMN created_at SET NOT NULL;
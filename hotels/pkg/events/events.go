@@ -0,0 +1,137 @@
+package events
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsService "github.com/AleksK1NG/hotels-mocroservices/hotels/proto/events"
+)
+
+const (
+	ContentType = "application/x-protobuf"
+
+	ResizeImageType       = "images.resize_image"
+	CreateImageType       = "images.create_image"
+	ImageCreatedType      = "images.image_created"
+	UploadHotelImageType  = "hotels.upload_hotel_image"
+	HotelImageUpdatedType = "hotels.hotel_image_updated"
+
+	UploadCommentPhotoType   = "comments.upload_comment_photo"
+	CommentPhotoUploadedType = "comments.comment_photo_uploaded"
+
+	UserUpdatedType = "users.user_updated"
+)
+
+// Payload schema version published and accepted for every event type
+var versions = map[string]uint32{
+	ResizeImageType:       1,
+	CreateImageType:       1,
+	ImageCreatedType:      1,
+	UploadHotelImageType:  1,
+	HotelImageUpdatedType: 1,
+
+	UploadCommentPhotoType:   1,
+	CommentPhotoUploadedType: 1,
+
+	UserUpdatedType: 1,
+}
+
+var (
+	ErrUnknownEventType   = errors.New("Unknown event type")
+	ErrUnsupportedVersion = errors.New("Unsupported event version")
+	ErrInvalidPayload     = errors.New("Invalid event payload")
+)
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns context carrying correlation id for published events
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, correlationID)
+}
+
+// CorrelationIDFromContext returns correlation id from context or empty string
+func CorrelationIDFromContext(ctx context.Context) string {
+	correlationID, ok := ctx.Value(correlationIDKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return correlationID
+}
+
+// Marshal fill envelope metadata by payload and serialize it
+func Marshal(ctx context.Context, env *eventsService.Envelope) ([]byte, error) {
+	eventType, err := payloadType(env)
+	if err != nil {
+		return nil, err
+	}
+
+	correlationID := CorrelationIDFromContext(ctx)
+	if correlationID == "" {
+		correlationID = uuid.NewV4().String()
+	}
+
+	env.EventID = uuid.NewV4().String()
+	env.Type = eventType
+	env.Version = versions[eventType]
+	env.OccurredAt = timestamppb.Now()
+	env.CorrelationID = correlationID
+
+	data, err := proto.Marshal(env)
+	if err != nil {
+		return nil, errors.Wrap(err, "proto.Marshal")
+	}
+
+	return data, nil
+}
+
+// Unmarshal parse envelope and validate its type, version and payload
+func Unmarshal(body []byte, eventType string) (*eventsService.Envelope, error) {
+	env := &eventsService.Envelope{}
+	if err := proto.Unmarshal(body, env); err != nil {
+		return nil, errors.Wrap(err, "proto.Unmarshal")
+	}
+
+	if env.GetType() != eventType {
+		return nil, errors.Wrapf(ErrUnknownEventType, "type: %s", env.GetType())
+	}
+	if env.GetVersion() != versions[eventType] {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "type: %s, version: %d", env.GetType(), env.GetVersion())
+	}
+
+	payload, err := payloadType(env)
+	if err != nil {
+		return nil, err
+	}
+	if payload != eventType {
+		return nil, errors.Wrapf(ErrInvalidPayload, "type: %s, payload: %s", eventType, payload)
+	}
+
+	return env, nil
+}
+
+func payloadType(env *eventsService.Envelope) (string, error) {
+	switch env.GetPayload().(type) {
+	case *eventsService.Envelope_ResizeImage:
+		return ResizeImageType, nil
+	case *eventsService.Envelope_CreateImage:
+		return CreateImageType, nil
+	case *eventsService.Envelope_ImageCreated:
+		return ImageCreatedType, nil
+	case *eventsService.Envelope_UploadHotelImage:
+		return UploadHotelImageType, nil
+	case *eventsService.Envelope_HotelImageUpdated:
+		return HotelImageUpdatedType, nil
+	case *eventsService.Envelope_UploadCommentPhoto:
+		return UploadCommentPhotoType, nil
+	case *eventsService.Envelope_CommentPhotoUploaded:
+		return CommentPhotoUploadedType, nil
+	case *eventsService.Envelope_UserUpdated:
+		return UserUpdatedType, nil
+	default:
+		return "", ErrInvalidPayload
+	}
+}
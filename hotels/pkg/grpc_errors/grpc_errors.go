@@ -17,6 +17,8 @@ var (
 	ErrNoCtxMetaData    = errors.New("No ctx metadata")
 	ErrInvalidSessionId = errors.New("Invalid session id")
 	ErrEmailExists      = errors.New("Email already exists")
+	ErrInvalidCursor    = errors.New("Invalid cursor")
+	ErrPermissionDenied = errors.New("Permission denied")
 )
 
 // Parse error and get code
@@ -34,6 +36,10 @@ func ParseGRPCErrStatusCode(err error) codes.Code {
 		return codes.Unauthenticated
 	case errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
+	case errors.Is(err, ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, ErrInvalidCursor):
+		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "Validate"):
 		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "redis"):
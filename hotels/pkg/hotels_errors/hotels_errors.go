@@ -8,4 +8,7 @@ var (
 	ErrInternalServerError    = errors.New("Internal server error")
 	ErrInvalidImageFormat     = errors.New("Invalid file format")
 	ErrHotelNotFound          = errors.New("Hotel not found")
+	ErrInvalidSortField       = errors.New("Invalid sort field")
+	ErrInvalidSortOrder       = errors.New("Invalid sort order")
+	ErrInvalidCursorSort      = errors.New("Cursor pagination supports only created_at sort")
 )
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := uuid.NewV4()
+
+	tests := []struct {
+		name      string
+		createdAt time.Time
+		id        uuid.UUID
+	}{
+		{name: "utc time", createdAt: time.Date(2021, 2, 3, 4, 5, 6, 7000, time.UTC), id: id},
+		{name: "zero time", createdAt: time.Time{}, id: id},
+		{name: "nil id", createdAt: time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC), id: uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := EncodeCursor(tt.createdAt, tt.id)
+			if encoded == "" {
+				t.Fatal("EncodeCursor returned empty cursor")
+			}
+
+			decoded, err := DecodeCursor(encoded)
+			if err != nil {
+				t.Fatalf("DecodeCursor: %v", err)
+			}
+			if !decoded.CreatedAt.Equal(tt.createdAt) {
+				t.Errorf("CreatedAt = %v, want %v", decoded.CreatedAt, tt.createdAt)
+			}
+			if !uuid.Equal(decoded.ID, tt.id) {
+				t.Errorf("ID = %v, want %v", decoded.ID, tt.id)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "std base64 padding", cursor: "e30="},
+		{name: "not json", cursor: "bm90IGpzb24"},
+		{name: "invalid id", cursor: "eyJpZCI6IjEyMyJ9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor); err == nil {
+				t.Errorf("DecodeCursor(%q) error = nil, want error", tt.cursor)
+			}
+		})
+	}
+}
+
+func TestSetCursor(t *testing.T) {
+	valid := EncodeCursor(time.Now().UTC(), uuid.NewV4())
+
+	tests := []struct {
+		name       string
+		cursor     string
+		wantErr    bool
+		wantCursor bool
+	}{
+		{name: "empty means first page", cursor: "", wantCursor: false},
+		{name: "valid cursor", cursor: valid, wantCursor: true},
+		{name: "invalid cursor", cursor: "%%%", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewPaginationQuery(10, 1)
+			err := q.SetCursor(tt.cursor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetCursor error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (q.GetCursor() != nil) != tt.wantCursor {
+				t.Errorf("GetCursor() = %v, wantCursor %v", q.GetCursor(), tt.wantCursor)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
@@ -14,9 +15,11 @@ const (
 
 // Pagination query params
 type PaginationQuery struct {
-	Size    int    `json:"size,omitempty"`
-	Page    int    `json:"page,omitempty"`
-	OrderBy string `json:"orderBy,omitempty"`
+	Size    int     `json:"size,omitempty"`
+	Page    int     `json:"page,omitempty"`
+	OrderBy string  `json:"orderBy,omitempty"`
+	Order   string  `json:"order,omitempty"`
+	Cursor  *Cursor `json:"cursor,omitempty"`
 }
 
 // NewPaginationQuery
@@ -59,6 +62,26 @@ func (q *PaginationQuery) SetOrderBy(orderByQuery string) {
 	q.OrderBy = orderByQuery
 }
 
+// Set order direction asc or desc
+func (q *PaginationQuery) SetOrder(orderQuery string) {
+	q.Order = orderQuery
+}
+
+// Set cursor, empty cursor means first page
+func (q *PaginationQuery) SetCursor(cursorQuery string) error {
+	if cursorQuery == "" {
+		q.Cursor = nil
+		return nil
+	}
+	cursor, err := DecodeCursor(cursorQuery)
+	if err != nil {
+		return err
+	}
+	q.Cursor = cursor
+
+	return nil
+}
+
 // Get offset
 func (q *PaginationQuery) GetOffset() int {
 	if q.Page == 0 {
@@ -87,6 +110,21 @@ func (q *PaginationQuery) GetSize() int {
 	return q.Size
 }
 
+// Get order direction
+func (q *PaginationQuery) GetOrder() string {
+	return q.Order
+}
+
+// Is descending order
+func (q *PaginationQuery) IsDesc() bool {
+	return strings.EqualFold(q.Order, "desc")
+}
+
+// Get cursor
+func (q *PaginationQuery) GetCursor() *Cursor {
+	return q.Cursor
+}
+
 func (q *PaginationQuery) GetQueryString() string {
 	return fmt.Sprintf("page=%v&size=%v&orderBy=%s", q.GetPage(), q.GetSize(), q.GetOrderBy())
 }
@@ -101,6 +139,10 @@ func GetPaginationFromCtx(c echo.Context) (*PaginationQuery, error) {
 		return nil, err
 	}
 	q.SetOrderBy(c.QueryParam("orderBy"))
+	q.SetOrder(c.QueryParam("order"))
+	if err := q.SetCursor(c.QueryParam("cursor")); err != nil {
+		return nil, err
+	}
 
 	return q, nil
 }
@@ -61,6 +61,18 @@ type RabbitMQ struct {
 	RoutingKey     string
 	ConsumerTag    string
 	WorkerPoolSize int
+	DrainTimeout   time.Duration
+
+	ResizeConsumer             RabbitMQConsumer
+	CreateConsumer             RabbitMQConsumer
+	UploadHotelImageConsumer   RabbitMQConsumer
+	UploadCommentPhotoConsumer RabbitMQConsumer
+}
+
+// RabbitMQConsumer worker pool and prefetch config of single queue consumer
+type RabbitMQConsumer struct {
+	WorkerPoolSize int
+	PrefetchCount  int
 }
 
 type AWS struct {
@@ -22,7 +22,6 @@ const (
 	publishMandatory = false
 	publishImmediate = false
 
-	prefetchCount  = 1
 	prefetchSize   = 0
 	prefetchGlobal = false
 
@@ -33,20 +32,24 @@ const (
 
 	ImagesExchange = "images"
 
+	deadLetterExchange    = "images_dead_letter"
+	deadLetterQueueSuffix = "_dlq"
+
 	ResizeQueueName   = "resize_queue"
 	ResizeConsumerTag = "resize_consumer"
-	ResizeWorkers     = 10
 	ResizeBindingKey  = "resize_image_key"
 
 	CreateQueueName   = "create_queue"
 	CreateConsumerTag = "create_consumer"
-	CreateWorkers     = 5
 	CreateBindingKey  = "create_image_key"
 
 	UploadHotelImageQueue       = "upload_hotel_image_queue"
 	UploadHotelImageConsumerTag = "upload_hotel_image_consumer_tag"
-	UploadHotelImageWorkers     = 10
 	UploadHotelImageBindingKey  = "upload_hotel_image_binding_key"
+
+	UploadCommentPhotoQueue       = "upload_comment_photo_queue"
+	UploadCommentPhotoConsumerTag = "upload_comment_photo_consumer_tag"
+	UploadCommentPhotoBindingKey  = "upload_comment_photo_binding_key"
 )
 
 var (
@@ -62,6 +65,10 @@ var (
 		Name: "rabbitmq_images_error_messages_total",
 		Help: "The total number of error incoming success RabbitMQ messages",
 	})
+	busyWorkers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rabbitmq_images_busy_workers",
+		Help: "The number of workers processing RabbitMQ delivery per queue",
+	}, []string{"queue"})
 )
 
 // Initialize consumers
@@ -76,6 +83,12 @@ func (c *ImageConsumer) Initialize() error {
 	}
 	c.channels = append(c.channels, updateImageChan)
 
+	commentPhotoChan, err := c.CreateExchangeAndQueue(ImagesExchange, UploadCommentPhotoQueue, UploadCommentPhotoBindingKey)
+	if err != nil {
+		return errors.Wrap(err, "CreateExchangeAndQueue")
+	}
+	c.channels = append(c.channels, commentPhotoChan)
+
 	resizeChan, err := c.CreateExchangeAndQueue(ImagesExchange, ResizeQueueName, ResizeBindingKey)
 	if err != nil {
 		return errors.Wrap(err, "CreateExchangeAndQueue")
@@ -93,6 +106,9 @@ func (c *ImageConsumer) Initialize() error {
 
 // CloseChannels close active channels
 func (c *ImageConsumer) CloseChannels() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	for _, channel := range c.channels {
 		go func(ch *amqp.Channel) {
 			if err := ch.Close(); err != nil {
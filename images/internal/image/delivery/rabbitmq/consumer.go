@@ -3,6 +3,7 @@ package rabbitmq
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/streadway/amqp"
@@ -16,6 +17,7 @@ import (
 type Consumer struct {
 	Worker         func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery)
 	WorkerPoolSize int
+	PrefetchCount  int
 	QueueName      string
 	ConsumerTag    string
 }
@@ -27,10 +29,14 @@ type ImageConsumer struct {
 	imageUC   image.UseCase
 	consumers []*Consumer
 	channels  []*amqp.Channel
+
+	mu              sync.Mutex
+	consumeChannels map[string]*amqp.Channel
+	workers         sync.WaitGroup
 }
 
 func NewImageConsumer(logger logger.Logger, cfg *config.Config, imageUC image.UseCase) *ImageConsumer {
-	return &ImageConsumer{logger: logger, cfg: cfg, imageUC: imageUC}
+	return &ImageConsumer{logger: logger, cfg: cfg, imageUC: imageUC, consumeChannels: make(map[string]*amqp.Channel)}
 }
 
 func (c *ImageConsumer) Dial() error {
@@ -63,6 +69,12 @@ func (c *ImageConsumer) CreateExchangeAndQueue(exchangeName, queueName, bindingK
 		return nil, errors.Wrap(err, "Error ch.ExchangeDeclare")
 	}
 
+	if err := c.declareDeadLetterQueue(ch, queueName); err != nil {
+		return nil, errors.Wrap(err, "declareDeadLetterQueue")
+	}
+
+	// rejected deliveries (invalid payload, unknown event version) are routed to queueName_dlq by broker policy
+	// (make rabbitmq_policies), x-dead-letter arguments would fail with PRECONDITION_FAILED on already declared queues
 	queue, err := ch.QueueDeclare(
 		queueName,
 		queueDurable,
@@ -95,22 +107,54 @@ func (c *ImageConsumer) CreateExchangeAndQueue(exchangeName, queueName, bindingK
 		return nil, errors.Wrap(err, "Error ch.QueueBind")
 	}
 
-	err = ch.Qos(
-		prefetchCount,  // prefetch count
-		prefetchSize,   // prefetch size
-		prefetchGlobal, // global
+	return ch, nil
+}
+
+// declareDeadLetterQueue declare dead letter exchange and queue for rejected deliveries of queueName
+func (c *ImageConsumer) declareDeadLetterQueue(ch *amqp.Channel, queueName string) error {
+	c.logger.Infof("Declaring dead letter exchange: %s", deadLetterExchange)
+	if err := ch.ExchangeDeclare(
+		deadLetterExchange,
+		exchangeKind,
+		exchangeDurable,
+		exchangeAutoDelete,
+		exchangeInternal,
+		exchangeNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.ExchangeDeclare")
+	}
+
+	dlq, err := ch.QueueDeclare(
+		queueName+deadLetterQueueSuffix,
+		queueDurable,
+		queueAutoDelete,
+		queueExclusive,
+		queueNoWait,
+		nil,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error  ch.Qos")
+		return errors.Wrap(err, "Error ch.QueueDeclare")
 	}
 
-	return ch, nil
+	if err := ch.QueueBind(
+		dlq.Name,
+		queueName,
+		deadLetterExchange,
+		queueNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.QueueBind")
+	}
+
+	return nil
 }
 
 func (c *ImageConsumer) startConsume(
 	ctx context.Context,
 	worker func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery),
 	workerPoolSize int,
+	prefetchCount int,
 	queueName string,
 	consumerTag string,
 ) error {
@@ -119,6 +163,15 @@ func (c *ImageConsumer) startConsume(
 		return errors.Wrap(err, "c.amqpConn.Channel")
 	}
 
+	err = ch.Qos(
+		prefetchCount,  // prefetch count
+		prefetchSize,   // prefetch size
+		prefetchGlobal, // global
+	)
+	if err != nil {
+		return errors.Wrap(err, "Error  ch.Qos")
+	}
+
 	deliveries, err := ch.Consume(
 		queueName,
 		consumerTag,
@@ -132,6 +185,11 @@ func (c *ImageConsumer) startConsume(
 		return errors.Wrap(err, "ch.Consume")
 	}
 
+	c.mu.Lock()
+	c.channels = append(c.channels, ch)
+	c.consumeChannels[consumerTag] = ch
+	c.mu.Unlock()
+
 	wg := &sync.WaitGroup{}
 
 	wg.Add(workerPoolSize)
@@ -139,6 +197,12 @@ func (c *ImageConsumer) startConsume(
 		go worker(ctx, wg, deliveries)
 	}
 
+	c.workers.Add(1)
+	go func() {
+		defer c.workers.Done()
+		wg.Wait()
+	}()
+
 	chanErr := <-ch.NotifyClose(make(chan *amqp.Error))
 	c.logger.Errorf("ch.NotifyClose: %v", chanErr)
 
@@ -159,6 +223,7 @@ func (c *ImageConsumer) run(ctx context.Context, cancel context.CancelFunc) {
 				ctx,
 				consumer.Worker,
 				consumer.WorkerPoolSize,
+				consumer.PrefetchCount,
 				consumer.QueueName,
 				consumer.ConsumerTag,
 			); err != nil {
@@ -173,21 +238,55 @@ func (c *ImageConsumer) run(ctx context.Context, cancel context.CancelFunc) {
 func (c *ImageConsumer) RunConsumers(ctx context.Context, cancel context.CancelFunc) {
 	c.AddConsumer(&Consumer{
 		Worker:         c.resizeWorker,
-		WorkerPoolSize: ResizeWorkers,
+		WorkerPoolSize: c.cfg.RabbitMQ.ResizeConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.ResizeConsumer.PrefetchCount,
 		QueueName:      ResizeQueueName,
 		ConsumerTag:    ResizeConsumerTag,
 	})
 	c.AddConsumer(&Consumer{
 		Worker:         c.createImageWorker,
-		WorkerPoolSize: CreateWorkers,
+		WorkerPoolSize: c.cfg.RabbitMQ.CreateConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.CreateConsumer.PrefetchCount,
 		QueueName:      CreateQueueName,
 		ConsumerTag:    CreateConsumerTag,
 	})
 	c.AddConsumer(&Consumer{
 		Worker:         c.processHotelImageWorker,
-		WorkerPoolSize: UploadHotelImageWorkers,
+		WorkerPoolSize: c.cfg.RabbitMQ.UploadHotelImageConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.UploadHotelImageConsumer.PrefetchCount,
 		QueueName:      UploadHotelImageQueue,
 		ConsumerTag:    UploadHotelImageConsumerTag,
 	})
+	c.AddConsumer(&Consumer{
+		Worker:         c.processCommentPhotoWorker,
+		WorkerPoolSize: c.cfg.RabbitMQ.UploadCommentPhotoConsumer.WorkerPoolSize,
+		PrefetchCount:  c.cfg.RabbitMQ.UploadCommentPhotoConsumer.PrefetchCount,
+		QueueName:      UploadCommentPhotoQueue,
+		ConsumerTag:    UploadCommentPhotoConsumerTag,
+	})
 	c.run(ctx, cancel)
 }
+
+// Drain cancel consumers and wait until workers process and ack in-flight deliveries, must be called before CloseChannels
+func (c *ImageConsumer) Drain(timeout time.Duration) {
+	c.mu.Lock()
+	for consumerTag, ch := range c.consumeChannels {
+		if err := ch.Cancel(consumerTag, consumeNoWait); err != nil {
+			c.logger.Errorf("Drain ch.Cancel: %v", err)
+		}
+	}
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("Consumers drained")
+	case <-time.After(timeout):
+		c.logger.Errorf("Consumers drain timeout: %v", timeout)
+	}
+}
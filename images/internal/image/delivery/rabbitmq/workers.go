@@ -11,27 +11,7 @@ import (
 func (c *ImageConsumer) resizeWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
 	defer wg.Done()
 	for delivery := range messages {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "ImageConsumer.resizeWorker")
-
-		c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
-
-		incomingMessages.Inc()
-
-		err := c.imageUC.ResizeImage(ctx, delivery)
-		if err != nil {
-			if err := delivery.Reject(false); err != nil {
-				c.logger.Errorf("Err delivery.Reject: %v", err)
-			}
-			c.logger.Errorf("Failed to process delivery: %v", err)
-			errorMessages.Inc()
-		} else {
-			err = delivery.Ack(false)
-			if err != nil {
-				c.logger.Errorf("Failed to acknowledge delivery: %v", err)
-			}
-			successMessages.Inc()
-		}
-		span.Finish()
+		c.processDelivery(ctx, "ImageConsumer.resizeWorker", ResizeQueueName, delivery, c.imageUC.ResizeImage)
 	}
 
 	c.logger.Info("Deliveries channel closed")
@@ -40,29 +20,7 @@ func (c *ImageConsumer) resizeWorker(ctx context.Context, wg *sync.WaitGroup, me
 func (c *ImageConsumer) createImageWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
 	defer wg.Done()
 	for delivery := range messages {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "ImageConsumer.createImageWorker")
-
-		c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
-
-		incomingMessages.Inc()
-
-		err := c.imageUC.Create(ctx, delivery)
-		if err != nil {
-			if err := delivery.Reject(false); err != nil {
-				c.logger.Errorf("Err delivery.Reject: %v", err)
-			}
-			c.logger.Errorf("Failed to process delivery: %v", err)
-			errorMessages.Inc()
-		} else {
-			err = delivery.Ack(false)
-			if err != nil {
-				c.logger.Errorf("Failed to acknowledge delivery: %v", err)
-				errorMessages.Inc()
-				continue
-			}
-			successMessages.Inc()
-		}
-		span.Finish()
+		c.processDelivery(ctx, "ImageConsumer.createImageWorker", CreateQueueName, delivery, c.imageUC.Create)
 	}
 
 	c.logger.Info("Deliveries channel closed")
@@ -71,30 +29,52 @@ func (c *ImageConsumer) createImageWorker(ctx context.Context, wg *sync.WaitGrou
 func (c *ImageConsumer) processHotelImageWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
 	defer wg.Done()
 	for delivery := range messages {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "ImageConsumer.createImageWorker")
+		c.processDelivery(ctx, "ImageConsumer.processHotelImageWorker", UploadHotelImageQueue, delivery, c.imageUC.ProcessHotelImage)
+	}
 
-		c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
+	c.logger.Info("Deliveries channel closed")
+}
 
-		incomingMessages.Inc()
+func (c *ImageConsumer) processCommentPhotoWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
+	defer wg.Done()
+	for delivery := range messages {
+		c.processDelivery(ctx, "ImageConsumer.processCommentPhotoWorker", UploadCommentPhotoQueue, delivery, c.imageUC.ProcessCommentPhoto)
+	}
 
-		err := c.imageUC.ProcessHotelImage(ctx, delivery)
-		if err != nil {
-			if err := delivery.Reject(false); err != nil {
-				c.logger.Errorf("Err delivery.Reject: %v", err)
-			}
-			c.logger.Errorf("Failed to process delivery: %v", err)
-			errorMessages.Inc()
-		} else {
-			err = delivery.Ack(false)
-			if err != nil {
-				c.logger.Errorf("Failed to acknowledge delivery: %v", err)
-				errorMessages.Inc()
-				continue
-			}
-			successMessages.Inc()
+	c.logger.Info("Deliveries channel closed")
+}
+
+// processDelivery ack processed delivery or reject it to dead letter queue,
+// span and busy workers gauge are finished once whatever the outcome is
+func (c *ImageConsumer) processDelivery(
+	ctx context.Context,
+	operationName string,
+	queueName string,
+	delivery amqp.Delivery,
+	process func(ctx context.Context, delivery amqp.Delivery) error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, operationName)
+	defer span.Finish()
+
+	c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
+
+	incomingMessages.Inc()
+	busyWorkers.WithLabelValues(queueName).Inc()
+	defer busyWorkers.WithLabelValues(queueName).Dec()
+
+	if err := process(ctx, delivery); err != nil {
+		if err := delivery.Reject(false); err != nil {
+			c.logger.Errorf("Err delivery.Reject: %v", err)
 		}
-		span.Finish()
+		c.logger.Errorf("Failed to process delivery: %v", err)
+		errorMessages.Inc()
+		return
 	}
 
-	c.logger.Info("Deliveries channel closed")
+	if err := delivery.Ack(false); err != nil {
+		c.logger.Errorf("Failed to acknowledge delivery: %v", err)
+		errorMessages.Inc()
+		return
+	}
+	successMessages.Inc()
 }
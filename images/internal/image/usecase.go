@@ -12,6 +12,7 @@ import (
 type UseCase interface {
 	ResizeImage(ctx context.Context, delivery amqp.Delivery) error
 	ProcessHotelImage(ctx context.Context, delivery amqp.Delivery) error
+	ProcessCommentPhoto(ctx context.Context, delivery amqp.Delivery) error
 	Create(ctx context.Context, delivery amqp.Delivery) error
 	GetImageByID(ctx context.Context, imageID uuid.UUID) (*models.Image, error)
 }
@@ -3,7 +3,6 @@ package usecase
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"image"
 	"image/gif"
 	"image/jpeg"
@@ -15,13 +14,16 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/streadway/amqp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	img "github.com/AleksK1NG/hotels-mocroservices/images-microservice/internal/image"
 	"github.com/AleksK1NG/hotels-mocroservices/images-microservice/internal/image/delivery/rabbitmq"
 	"github.com/AleksK1NG/hotels-mocroservices/images-microservice/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/images-microservice/pkg/events"
 	"github.com/AleksK1NG/hotels-mocroservices/images-microservice/pkg/image_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/images-microservice/pkg/images"
 	"github.com/AleksK1NG/hotels-mocroservices/images-microservice/pkg/logger"
+	eventsService "github.com/AleksK1NG/hotels-mocroservices/images-microservice/proto/events"
 )
 
 const (
@@ -29,13 +31,14 @@ const (
 	imageExchange          = "images"
 	updateAvatarRoutingKey = "update_avatar_key"
 	createImageRoutingKey  = "create_image_key"
-	userUUIDHeader         = "user_uuid"
 	resizeWidth            = 1024
 	resizeHeight           = 0
 
-	hotelsUUIDHeader      = "hotel_uuid"
 	hotelsExchange        = "hotels"
 	updateImageRoutingKey = "update_hotel_image_key"
+
+	commentsExchange             = "comments"
+	updateCommentPhotoRoutingKey = "update_comment_photos_key"
 )
 
 // imageUseCase
@@ -67,33 +70,44 @@ func (i *imageUseCase) Create(ctx context.Context, delivery amqp.Delivery) error
 
 	i.logger.Infof("amqp.Delivery: %-v", delivery.DeliveryTag)
 
-	var msg models.UploadImageMsg
-	if err := json.Unmarshal(delivery.Body, &msg); err != nil {
-		return err
+	env, err := events.Unmarshal(delivery.Body, events.CreateImageType)
+	if err != nil {
+		return errors.Wrap(err, "imageUseCase.Create.events.Unmarshal")
+	}
+	msg := env.GetCreateImage()
+
+	userID, err := uuid.FromString(msg.GetUserID())
+	if err != nil {
+		return errors.Wrap(err, "uuid.FromString")
 	}
 
 	createdImage, err := i.pgRepo.Create(ctx, &models.Image{
-		ImageID:    msg.ImageID,
-		ImageURL:   msg.ImageURL,
-		IsUploaded: msg.IsUploaded,
+		ImageURL:   msg.GetImageURL(),
+		IsUploaded: msg.GetIsUploaded(),
 	})
 	if err != nil {
 		return err
 	}
 
-	msgBytes, err := json.Marshal(createdImage)
+	msgBytes, err := events.Marshal(events.WithCorrelationID(ctx, env.GetCorrelationID()), &eventsService.Envelope{
+		Payload: &eventsService.Envelope_ImageCreated{ImageCreated: &eventsService.ImageCreated{
+			ImageID:    createdImage.ImageID.String(),
+			UserID:     userID.String(),
+			ImageURL:   createdImage.ImageURL,
+			IsUploaded: createdImage.IsUploaded,
+			CreatedAt:  timestamppb.New(createdImage.CreatedAt),
+		}},
+	})
 	if err != nil {
-		return errors.Wrap(err, "imageUseCase.Create.json.Marshal")
+		return errors.Wrap(err, "imageUseCase.Create.events.Marshal")
 	}
 
-	headers := make(amqp.Table)
-	headers[userUUIDHeader] = delivery.Headers[userUUIDHeader]
 	if err := i.publisher.Publish(
 		ctx,
 		userExchange,
 		updateAvatarRoutingKey,
-		delivery.ContentType,
-		headers,
+		events.ContentType,
+		nil,
 		msgBytes,
 	); err != nil {
 		return errors.Wrap(err, "imageUseCase.Create.Publish")
@@ -109,12 +123,18 @@ func (i *imageUseCase) ResizeImage(ctx context.Context, delivery amqp.Delivery)
 
 	i.logger.Infof("amqp.Delivery: %-v", delivery.DeliveryTag)
 
-	parsedUUID, err := i.validateDeliveryHeaders(delivery)
+	env, err := events.Unmarshal(delivery.Body, events.ResizeImageType)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "imageUseCase.ResizeImage.events.Unmarshal")
+	}
+	msg := env.GetResizeImage()
+
+	userID, err := uuid.FromString(msg.GetUserID())
+	if err != nil {
+		return errors.Wrap(err, "uuid.FromString")
 	}
 
-	processedImage, fileType, err := i.processImage(delivery.Body)
+	processedImage, fileType, err := i.processImage(msg.GetData())
 	if err != nil {
 		return err
 	}
@@ -125,25 +145,23 @@ func (i *imageUseCase) ResizeImage(ctx context.Context, delivery amqp.Delivery)
 		return err
 	}
 
-	msg := &models.UploadImageMsg{
-		UserID:     *parsedUUID,
-		ImageURL:   fileUrl,
-		IsUploaded: true,
-	}
-
-	msgBytes, err := json.Marshal(msg)
+	msgBytes, err := events.Marshal(events.WithCorrelationID(ctx, env.GetCorrelationID()), &eventsService.Envelope{
+		Payload: &eventsService.Envelope_CreateImage{CreateImage: &eventsService.CreateImage{
+			UserID:     userID.String(),
+			ImageURL:   fileUrl,
+			IsUploaded: true,
+		}},
+	})
 	if err != nil {
-		return errors.Wrap(err, "imageUseCase.ResizeImage.json.Marshal")
+		return errors.Wrap(err, "imageUseCase.ResizeImage.events.Marshal")
 	}
 
-	headers := make(amqp.Table)
-	headers[userUUIDHeader] = delivery.Headers[userUUIDHeader]
 	if err := i.publisher.Publish(
 		ctx,
 		imageExchange,
 		createImageRoutingKey,
-		delivery.ContentType,
-		headers,
+		events.ContentType,
+		nil,
 		msgBytes,
 	); err != nil {
 		return errors.Wrap(err, "imageUseCase.ResizeImage.Publish")
@@ -166,17 +184,23 @@ func (i *imageUseCase) GetImageByID(ctx context.Context, imageID uuid.UUID) (*mo
 
 // ProcessHotelImage
 func (i *imageUseCase) ProcessHotelImage(ctx context.Context, delivery amqp.Delivery) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "imageUseCase.Create")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "imageUseCase.ProcessHotelImage")
 	defer span.Finish()
 
 	i.logger.Infof("amqp.Delivery: %-v", delivery.DeliveryTag)
 
-	uuidHeader, err := i.extractUUIDHeader(delivery, hotelsUUIDHeader)
+	env, err := events.Unmarshal(delivery.Body, events.UploadHotelImageType)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "ProcessHotelImage.events.Unmarshal")
+	}
+	msg := env.GetUploadHotelImage()
+
+	hotelID, err := uuid.FromString(msg.GetHotelID())
+	if err != nil {
+		return errors.Wrap(err, "uuid.FromString")
 	}
 
-	processedImage, fileType, err := i.processImage(delivery.Body)
+	processedImage, fileType, err := i.processImage(msg.GetData())
 	if err != nil {
 		return err
 	}
@@ -187,24 +211,22 @@ func (i *imageUseCase) ProcessHotelImage(ctx context.Context, delivery amqp.Deli
 		return err
 	}
 
-	msg := &models.UpdateHotelImageMsg{
-		HotelID: *uuidHeader,
-		Image:   fileUrl,
-	}
-
-	msgBytes, err := json.Marshal(msg)
+	msgBytes, err := events.Marshal(events.WithCorrelationID(ctx, env.GetCorrelationID()), &eventsService.Envelope{
+		Payload: &eventsService.Envelope_HotelImageUpdated{HotelImageUpdated: &eventsService.HotelImageUpdated{
+			HotelID:  hotelID.String(),
+			ImageURL: fileUrl,
+		}},
+	})
 	if err != nil {
-		return errors.Wrap(err, "ProcessHotelImage.json.Marshal")
+		return errors.Wrap(err, "ProcessHotelImage.events.Marshal")
 	}
 
-	headers := make(amqp.Table)
-	headers[hotelsUUIDHeader] = delivery.Headers[hotelsUUIDHeader]
 	if err := i.publisher.Publish(
 		ctx,
 		hotelsExchange,
 		updateImageRoutingKey,
-		delivery.ContentType,
-		headers,
+		events.ContentType,
+		nil,
 		msgBytes,
 	); err != nil {
 		return errors.Wrap(err, "ProcessHotelImage.Publish")
@@ -213,44 +235,55 @@ func (i *imageUseCase) ProcessHotelImage(ctx context.Context, delivery amqp.Deli
 	return nil
 }
 
-func (i *imageUseCase) validateDeliveryHeaders(delivery amqp.Delivery) (*uuid.UUID, error) {
-	i.logger.Infof("amqp.Delivery header: %-v", delivery.Headers)
+// ProcessCommentPhoto
+func (i *imageUseCase) ProcessCommentPhoto(ctx context.Context, delivery amqp.Delivery) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "imageUseCase.ProcessCommentPhoto")
+	defer span.Finish()
 
-	userUUID, ok := delivery.Headers[userUUIDHeader]
-	if !ok {
-		return nil, image_errors.ErrInvalidDeliveryHeaders
-	}
-	userID, ok := userUUID.(string)
-	if !ok {
-		return nil, image_errors.ErrInvalidUUID
+	env, err := events.Unmarshal(delivery.Body, events.UploadCommentPhotoType)
+	if err != nil {
+		return errors.Wrap(err, "ProcessCommentPhoto.events.Unmarshal")
 	}
+	msg := env.GetUploadCommentPhoto()
 
-	parsedUUID, err := uuid.FromString(userID)
+	commentID, err := uuid.FromString(msg.GetCommentID())
 	if err != nil {
-		return nil, errors.Wrap(err, "uuid.FromString")
+		return errors.Wrap(err, "uuid.FromString")
 	}
 
-	return &parsedUUID, nil
-}
-
-func (i *imageUseCase) extractUUIDHeader(delivery amqp.Delivery, key string) (*uuid.UUID, error) {
-	i.logger.Infof("amqp.Delivery header: %-v", delivery.Headers)
-
-	uid, ok := delivery.Headers[key]
-	if !ok {
-		return nil, image_errors.ErrInvalidDeliveryHeaders
+	processedImage, fileType, err := i.processImage(msg.GetData())
+	if err != nil {
+		return err
 	}
-	userID, ok := uid.(string)
-	if !ok {
-		return nil, image_errors.ErrInvalidUUID
+
+	fileUrl, err := i.awsRepo.PutObject(ctx, processedImage, fileType)
+	if err != nil {
+		i.logger.Errorf("awsRepo.PutObject %-v", err)
+		return err
 	}
 
-	parsedUUID, err := uuid.FromString(userID)
+	msgBytes, err := events.Marshal(events.WithCorrelationID(ctx, env.GetCorrelationID()), &eventsService.Envelope{
+		Payload: &eventsService.Envelope_CommentPhotoUploaded{CommentPhotoUploaded: &eventsService.CommentPhotoUploaded{
+			CommentID: commentID.String(),
+			ImageURL:  fileUrl,
+		}},
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "uuid.FromString")
+		return errors.Wrap(err, "ProcessCommentPhoto.events.Marshal")
 	}
 
-	return &parsedUUID, nil
+	if err := i.publisher.Publish(
+		ctx,
+		commentsExchange,
+		updateCommentPhotoRoutingKey,
+		events.ContentType,
+		nil,
+		msgBytes,
+	); err != nil {
+		return errors.Wrap(err, "ProcessCommentPhoto.Publish")
+	}
+
+	return nil
 }
 
 func (i *imageUseCase) processImage(img []byte) ([]byte, string, error) {
@@ -18,20 +18,6 @@ type Image struct {
 	UpdatedAt  time.Time `json:"updated_at"`
 }
 
-// Event message for upload image
-type UploadImageMsg struct {
-	ImageID    uuid.UUID `json:"image_id"`
-	UserID     uuid.UUID `json:"user_id"`
-	ImageURL   string    `json:"image_url"`
-	IsUploaded bool      `json:"is_uploaded"`
-}
-
-// Event message for create image
-type CreateImageMsg struct {
-	ImageURL   string `json:"image_url"`
-	IsUploaded bool   `json:"is_uploaded"`
-}
-
 func (i *Image) ToProto() *imageService.Image {
 	return &imageService.Image{
 		ImageID:    i.ImageID.String(),
@@ -40,9 +26,3 @@ func (i *Image) ToProto() *imageService.Image {
 		CreatedAt:  timestamppb.New(i.CreatedAt),
 	}
 }
-
-// UpdateHotelImageMsg
-type UpdateHotelImageMsg struct {
-	HotelID uuid.UUID `json:"hotel_id"`
-	Image   string    `json:"image,omitempty"`
-}
@@ -125,6 +125,7 @@ func (s *Server) Run() error {
 	}
 
 	server.GracefulStop()
+	imageConsumer.Drain(s.cfg.RabbitMQ.DrainTimeout * time.Second)
 	s.logger.Info("Server Exited Properly")
 
 	return nil
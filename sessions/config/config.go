@@ -16,24 +16,42 @@ type Config struct {
 	Metrics    Metrics
 	Logger     Logger
 	Jaeger     Jaeger
+	CSRF       CSRF
+}
+
+// CSRF config, first key signs new tokens, the rest are accepted during rotation
+type CSRF struct {
+	Keys []CSRFKey
+}
+
+// CSRFKey HMAC key with id embedded into tokens
+type CSRFKey struct {
+	ID     string
+	Secret string
 }
 
 // GRPCServer config
 type GRPCServer struct {
-	AppVersion        string
-	Port              string
-	CookieLifeTime    int
-	CsrfExpire        int
-	SessionID         string
-	SessionExpire     int
-	Mode              string
-	SessionPrefix     string
-	CSRFPrefix        string
-	Timeout           time.Duration
-	ReadTimeout       time.Duration
-	WriteTimeout      time.Duration
-	MaxConnectionIdle time.Duration
-	MaxConnectionAge  time.Duration
+	AppVersion         string
+	Port               string
+	CookieLifeTime     int
+	CsrfExpire         int
+	SessionID          string
+	SessionExpire      int
+	SessionMaxAge      int
+	RefreshTokenExpire int
+	PendingLoginExpire int
+	PendingMaxAttempts int64
+	Mode               string
+	SessionPrefix      string
+	CSRFPrefix         string
+	RefreshPrefix      string
+	PendingPrefix      string
+	Timeout            time.Duration
+	ReadTimeout        time.Duration
+	WriteTimeout       time.Duration
+	MaxConnectionIdle  time.Duration
+	MaxConnectionAge   time.Duration
 }
 
 // Logger config
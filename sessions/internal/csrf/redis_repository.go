@@ -4,6 +4,7 @@ import "context"
 
 // CSRF RedisRepository
 type RedisRepository interface {
-	Create(ctx context.Context, token string) error
-	GetToken(ctx context.Context, token string) (string, error)
+	Create(ctx context.Context, sesID string, token string) error
+	GetToken(ctx context.Context, sesID string) (string, error)
+	Delete(ctx context.Context, sesIDs ...string) error
 }
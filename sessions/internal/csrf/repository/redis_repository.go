@@ -22,31 +22,52 @@ func NewCsrfRepository(redis *redis.Client, prefix string, duration time.Duratio
 	return &CsrfRepository{redis: redis, prefix: prefix, duration: duration}
 }
 
-// Create csrf token
-func (r *CsrfRepository) Create(ctx context.Context, token string) error {
+// Create csrf token of session
+func (r *CsrfRepository) Create(ctx context.Context, sesID string, token string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfRepository.Create")
 	defer span.Finish()
 
-	if err := r.redis.SetEX(ctx, r.createKey(token), token, r.duration).Err(); err != nil {
+	if err := r.redis.SetEX(ctx, r.createKey(sesID), token, r.duration).Err(); err != nil {
 		return errors.Wrap(err, "CsrfRepository.Create.redis.SetEX")
 	}
 
 	return nil
 }
 
-// Check csrf token
-func (r *CsrfRepository) GetToken(ctx context.Context, token string) (string, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfRepository.Check")
+// GetToken current csrf token of session
+func (r *CsrfRepository) GetToken(ctx context.Context, sesID string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfRepository.GetToken")
 	defer span.Finish()
 
-	token, err := r.redis.Get(ctx, r.createKey(token)).Result()
+	token, err := r.redis.Get(ctx, r.createKey(sesID)).Result()
 	if err != nil {
-		return "", err
+		return "", errors.Wrap(err, "CsrfRepository.GetToken.redis.Get")
 	}
 
 	return token, nil
 }
 
-func (r *CsrfRepository) createKey(token string) string {
-	return fmt.Sprintf("%s: %s", r.prefix, token)
+// Delete csrf tokens of ended sessions
+func (r *CsrfRepository) Delete(ctx context.Context, sesIDs ...string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfRepository.Delete")
+	defer span.Finish()
+
+	if len(sesIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(sesIDs))
+	for _, sesID := range sesIDs {
+		keys = append(keys, r.createKey(sesID))
+	}
+
+	if err := r.redis.Del(ctx, keys...).Err(); err != nil {
+		return errors.Wrap(err, "CsrfRepository.Delete.redis.Del")
+	}
+
+	return nil
+}
+
+func (r *CsrfRepository) createKey(sesID string) string {
+	return fmt.Sprintf("%s: %s", r.prefix, sesID)
 }
@@ -6,4 +6,5 @@ import "context"
 type UseCase interface {
 	GetCSRFToken(ctx context.Context, sesID string) (string, error)
 	ValidateCSRFToken(ctx context.Context, sesID string, token string) (bool, error)
+	DeleteSessionTokens(ctx context.Context, sesIDs ...string) error
 }
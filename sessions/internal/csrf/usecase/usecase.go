@@ -2,44 +2,72 @@ package usecase
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
-	"io"
+	"strings"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/config"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/csrf"
 )
 
 const (
 	CSRFHeader = "X-CSRF-Token"
-	// 32 bytes
-	csrfSalt = "KbWaoi5xtDC3GEfBa9ovQdzOzXsuVU9I"
+
+	nonceBytes     = 32
+	tokenSeparator = "."
 )
 
-// CSRF usecase
+// CSRF usecase, tokens are random nonces signed with HMAC of session id: keyID.nonce.mac
 type CsrfUseCase struct {
-	csrfRepo csrf.RedisRepository
+	csrfRepo    csrf.RedisRepository
+	activeKeyID string
+	keys        map[string][]byte
 }
 
 // NewCsrfUC
-func NewCsrfUseCase(csrfRepo csrf.RedisRepository) *CsrfUseCase {
-	return &CsrfUseCase{csrfRepo: csrfRepo}
+func NewCsrfUseCase(csrfRepo csrf.RedisRepository, cfg config.CSRF) (*CsrfUseCase, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, errors.New("csrf keys are not configured")
+	}
+
+	keys := make(map[string][]byte, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		if key.ID == "" || key.Secret == "" || strings.Contains(key.ID, tokenSeparator) {
+			return nil, errors.Errorf("invalid csrf key: %q", key.ID)
+		}
+		keys[key.ID] = []byte(key.Secret)
+	}
+
+	return &CsrfUseCase{csrfRepo: csrfRepo, activeKeyID: cfg.Keys[0].ID, keys: keys}, nil
 }
 
-// Create new CSRF token
+// GetCSRFToken returns current session token, new one is created if missing or signed with rotated key
 func (c *CsrfUseCase) GetCSRFToken(ctx context.Context, sesID string) (string, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfUseCase.CreateToken")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfUseCase.GetCSRFToken")
 	defer span.Finish()
 
+	existsToken, err := c.csrfRepo.GetToken(ctx, sesID)
+	if err != nil && errors.Cause(err) != redis.Nil {
+		return "", errors.Wrap(err, "CsrfUseCase.GetCSRFToken.csrfRepo.GetToken")
+	}
+	if existsToken != "" && strings.HasPrefix(existsToken, c.activeKeyID+tokenSeparator) {
+		return existsToken, nil
+	}
+
 	token, err := c.makeToken(sesID)
 	if err != nil {
-		return "", errors.Wrap(err, "CsrfUseCase.CreateToken.c.makeToken")
+		return "", errors.Wrap(err, "CsrfUseCase.GetCSRFToken.c.makeToken")
 	}
 
-	if err := c.csrfRepo.Create(ctx, token); err != nil {
-		return "", errors.Wrap(err, "CsrfUseCase.CreateToken.csrfRepo.Create")
+	if err := c.csrfRepo.Create(ctx, sesID, token); err != nil {
+		return "", errors.Wrap(err, "CsrfUseCase.GetCSRFToken.csrfRepo.Create")
 	}
 
 	return token, nil
@@ -47,32 +75,62 @@ func (c *CsrfUseCase) GetCSRFToken(ctx context.Context, sesID string) (string, e
 
 // Validate csrf token using session id and token
 func (c *CsrfUseCase) ValidateCSRFToken(ctx context.Context, sesID string, token string) (bool, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfUseCase.CheckToken")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfUseCase.ValidateCSRFToken")
 	defer span.Finish()
 
-	existsToken, err := c.csrfRepo.GetToken(ctx, token)
+	if !c.verifyToken(token, sesID) {
+		return false, nil
+	}
+
+	existsToken, err := c.csrfRepo.GetToken(ctx, sesID)
 	if err != nil {
 		return false, err
 	}
 
-	return c.validateToken(existsToken, sesID), nil
+	return subtle.ConstantTimeCompare([]byte(existsToken), []byte(token)) == 1, nil
+}
+
+// DeleteSessionTokens expire tokens of ended sessions
+func (c *CsrfUseCase) DeleteSessionTokens(ctx context.Context, sesIDs ...string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CsrfUseCase.DeleteSessionTokens")
+	defer span.Finish()
+
+	return c.csrfRepo.Delete(ctx, sesIDs...)
 }
 
 func (c *CsrfUseCase) makeToken(sessionID string) (string, error) {
-	hash := sha256.New()
-	_, err := io.WriteString(hash, csrfSalt+sessionID)
-	if err != nil {
+	nonce := make([]byte, nonceBytes)
+	if _, err := rand.Read(nonce); err != nil {
 		return "", err
 	}
-	token := base64.RawStdEncoding.EncodeToString(hash.Sum(nil))
-	return token, nil
+	encodedNonce := base64.RawURLEncoding.EncodeToString(nonce)
+	mac := c.sign(c.keys[c.activeKeyID], sessionID, encodedNonce)
+
+	return strings.Join([]string{c.activeKeyID, encodedNonce, base64.RawURLEncoding.EncodeToString(mac)}, tokenSeparator), nil
 }
 
-// Validate CSRF token
-func (c *CsrfUseCase) validateToken(token string, sessionID string) bool {
-	trueToken, err := c.makeToken(sessionID)
+// verifyToken check token signature with the key it was signed with
+func (c *CsrfUseCase) verifyToken(token string, sessionID string) bool {
+	parts := strings.Split(token, tokenSeparator)
+	if len(parts) != 3 {
+		return false
+	}
+
+	key, ok := c.keys[parts[0]]
+	if !ok {
+		return false
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(parts[2])
 	if err != nil {
 		return false
 	}
-	return token == trueToken
+
+	return hmac.Equal(mac, c.sign(key, sessionID, parts[1]))
+}
+
+func (c *CsrfUseCase) sign(key []byte, sessionID string, nonce string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(sessionID + tokenSeparator + nonce))
+	return h.Sum(nil)
 }
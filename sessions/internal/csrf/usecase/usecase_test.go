@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/config"
+)
+
+// memoryCsrfRepo in memory csrf.RedisRepository
+type memoryCsrfRepo struct {
+	tokens map[string]string
+}
+
+func newMemoryCsrfRepo() *memoryCsrfRepo {
+	return &memoryCsrfRepo{tokens: make(map[string]string)}
+}
+
+func (r *memoryCsrfRepo) Create(ctx context.Context, sesID string, token string) error {
+	r.tokens[sesID] = token
+	return nil
+}
+
+func (r *memoryCsrfRepo) GetToken(ctx context.Context, sesID string) (string, error) {
+	token, ok := r.tokens[sesID]
+	if !ok {
+		return "", redis.Nil
+	}
+	return token, nil
+}
+
+func (r *memoryCsrfRepo) Delete(ctx context.Context, sesIDs ...string) error {
+	for _, sesID := range sesIDs {
+		delete(r.tokens, sesID)
+	}
+	return nil
+}
+
+func newTestCsrfUseCase(t *testing.T, repo *memoryCsrfRepo, keys ...config.CSRFKey) *CsrfUseCase {
+	t.Helper()
+	uc, err := NewCsrfUseCase(repo, config.CSRF{Keys: keys})
+	if err != nil {
+		t.Fatalf("NewCsrfUseCase: %v", err)
+	}
+	return uc
+}
+
+func TestNewCsrfUseCaseKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []config.CSRFKey
+		wantErr bool
+	}{
+		{name: "single key", keys: []config.CSRFKey{{ID: "k1", Secret: "secret"}}},
+		{name: "rotation keys", keys: []config.CSRFKey{{ID: "k2", Secret: "new"}, {ID: "k1", Secret: "old"}}},
+		{name: "no keys", wantErr: true},
+		{name: "empty id", keys: []config.CSRFKey{{ID: "", Secret: "secret"}}, wantErr: true},
+		{name: "empty secret", keys: []config.CSRFKey{{ID: "k1", Secret: ""}}, wantErr: true},
+		{name: "id with separator", keys: []config.CSRFKey{{ID: "k.1", Secret: "secret"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCsrfUseCase(newMemoryCsrfRepo(), config.CSRF{Keys: tt.keys})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCsrfUseCase error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+	uc := newTestCsrfUseCase(t, newMemoryCsrfRepo(), config.CSRFKey{ID: "k1", Secret: "secret"})
+	token, err := uc.makeToken("session")
+	if err != nil {
+		t.Fatalf("makeToken: %v", err)
+	}
+	parts := strings.Split(token, tokenSeparator)
+
+	tests := []struct {
+		name      string
+		token     string
+		sessionID string
+		want      bool
+	}{
+		{name: "valid token", token: token, sessionID: "session", want: true},
+		{name: "other session", token: token, sessionID: "other", want: false},
+		{name: "tampered nonce", token: strings.Join([]string{parts[0], parts[1] + "x", parts[2]}, tokenSeparator), sessionID: "session"},
+		{name: "tampered mac", token: strings.Join([]string{parts[0], parts[1], parts[2] + "x"}, tokenSeparator), sessionID: "session"},
+		{name: "unknown key", token: strings.Join([]string{"k9", parts[1], parts[2]}, tokenSeparator), sessionID: "session"},
+		{name: "missing parts", token: parts[0] + tokenSeparator + parts[1], sessionID: "session"},
+		{name: "empty token", token: "", sessionID: "session"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uc.verifyToken(tt.token, tt.sessionID); got != tt.want {
+				t.Errorf("verifyToken = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRFKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey := config.CSRFKey{ID: "k1", Secret: "old"}
+	newKey := config.CSRFKey{ID: "k2", Secret: "new"}
+
+	repo := newMemoryCsrfRepo()
+	oldUC := newTestCsrfUseCase(t, repo, oldKey)
+	oldToken, err := oldUC.GetCSRFToken(ctx, "session")
+	if err != nil {
+		t.Fatalf("GetCSRFToken: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		keys []config.CSRFKey
+		want bool
+	}{
+		{name: "old key still accepted during rotation", keys: []config.CSRFKey{newKey, oldKey}, want: true},
+		{name: "old key removed after rotation", keys: []config.CSRFKey{newKey}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestCsrfUseCase(t, repo, tt.keys...)
+			valid, err := uc.ValidateCSRFToken(ctx, "session", oldToken)
+			if err != nil {
+				t.Fatalf("ValidateCSRFToken: %v", err)
+			}
+			if valid != tt.want {
+				t.Errorf("ValidateCSRFToken = %v, want %v", valid, tt.want)
+			}
+		})
+	}
+
+	rotatedUC := newTestCsrfUseCase(t, repo, newKey, oldKey)
+	newToken, err := rotatedUC.GetCSRFToken(ctx, "session")
+	if err != nil {
+		t.Fatalf("GetCSRFToken: %v", err)
+	}
+	if !strings.HasPrefix(newToken, newKey.ID+tokenSeparator) {
+		t.Errorf("GetCSRFToken = %q, want token signed with active key %q", newToken, newKey.ID)
+	}
+	if valid, _ := rotatedUC.ValidateCSRFToken(ctx, "session", oldToken); valid {
+		t.Error("ValidateCSRFToken accepted replaced token")
+	}
+
+	sameToken, err := rotatedUC.GetCSRFToken(ctx, "session")
+	if err != nil {
+		t.Fatalf("GetCSRFToken: %v", err)
+	}
+	if sameToken != newToken {
+		t.Errorf("GetCSRFToken = %q, want existing token %q", sameToken, newToken)
+	}
+}
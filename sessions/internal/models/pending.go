@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// PendingLogin first login step passed with password, second factor is not verified yet
+type PendingLogin struct {
+	UserID    uuid.UUID `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Attempts  int64     `json:"-"`
+}
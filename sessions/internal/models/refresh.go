@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// RefreshFamily chain of rotated refresh tokens issued from one login
+type RefreshFamily struct {
+	FamilyID  string    `json:"family_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RefreshToken stored by token hash
+type RefreshToken struct {
+	FamilyID  string    `json:"family_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -1,9 +1,19 @@
 package models
 
-import uuid "github.com/satori/go.uuid"
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
 
 // Session model
 type Session struct {
-	SessionID string    `json:"session_id"`
-	UserID    uuid.UUID `json:"user_id"`
+	SessionID  string    `json:"-"`
+	UserID     uuid.UUID `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Renewed    bool      `json:"-"`
 }
@@ -0,0 +1,14 @@
+package pending
+
+import (
+	"context"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+// Pending logins RedisRepository
+type RedisRepository interface {
+	Create(ctx context.Context, tokenHash string, login *models.PendingLogin) error
+	GetAndCountAttempt(ctx context.Context, tokenHash string) (*models.PendingLogin, error)
+	Delete(ctx context.Context, tokenHash string) error
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+// getAndCountAttempt returns pending login and attempts counter, counter expires with the login
+var getAndCountAttempt = redis.NewScript(`
+local data = redis.call('GET', KEYS[1])
+if not data then
+	return false
+end
+local attempts = redis.call('INCR', KEYS[2])
+if attempts == 1 then
+	redis.call('PEXPIRE', KEYS[2], redis.call('PTTL', KEYS[1]))
+end
+return {data, attempts}
+`)
+
+type pendingRedisRepo struct {
+	redis      *redis.Client
+	prefix     string
+	expiration time.Duration
+}
+
+func NewPendingRedisRepo(redis *redis.Client, prefix string, expiration time.Duration) *pendingRedisRepo {
+	return &pendingRedisRepo{redis: redis, prefix: prefix, expiration: expiration}
+}
+
+func (r *pendingRedisRepo) Create(ctx context.Context, tokenHash string, login *models.PendingLogin) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pendingRedisRepo.Create")
+	defer span.Finish()
+
+	loginBytes, err := json.Marshal(login)
+	if err != nil {
+		return errors.Wrap(err, "pendingRepo.Create.json.Marshal")
+	}
+
+	if err := r.redis.SetEX(ctx, r.createKey(tokenHash), string(loginBytes), r.expiration).Err(); err != nil {
+		return errors.Wrap(err, "pendingRepo.Create.redis.SetEX")
+	}
+	return nil
+}
+
+// GetAndCountAttempt get pending login and atomically count verification attempt
+func (r *pendingRedisRepo) GetAndCountAttempt(ctx context.Context, tokenHash string) (*models.PendingLogin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pendingRedisRepo.GetAndCountAttempt")
+	defer span.Finish()
+
+	res, err := getAndCountAttempt.Run(ctx, r.redis, []string{r.createKey(tokenHash), r.createAttemptsKey(tokenHash)}).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "pendingRepo.GetAndCountAttempt.redis.Run")
+	}
+
+	values, ok := res.([]interface{})
+	if !ok || len(values) != 2 {
+		return nil, errors.Errorf("pendingRepo.GetAndCountAttempt unexpected script result: %v", res)
+	}
+	data, _ := values[0].(string)
+	attempts, _ := values[1].(int64)
+
+	var login models.PendingLogin
+	if err := json.Unmarshal([]byte(data), &login); err != nil {
+		return nil, errors.Wrap(err, "pendingRepo.GetAndCountAttempt.json.Unmarshal")
+	}
+	login.Attempts = attempts
+
+	return &login, nil
+}
+
+func (r *pendingRedisRepo) Delete(ctx context.Context, tokenHash string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pendingRedisRepo.Delete")
+	defer span.Finish()
+
+	if err := r.redis.Del(ctx, r.createKey(tokenHash), r.createAttemptsKey(tokenHash)).Err(); err != nil {
+		return errors.Wrap(err, "pendingRepo.Delete.redis.Del")
+	}
+	return nil
+}
+
+func (r *pendingRedisRepo) createKey(tokenHash string) string {
+	return fmt.Sprintf("%s: %s", r.prefix, tokenHash)
+}
+
+func (r *pendingRedisRepo) createAttemptsKey(tokenHash string) string {
+	return fmt.Sprintf("%s_attempts: %s", r.prefix, tokenHash)
+}
@@ -0,0 +1,14 @@
+package pending
+
+import (
+	"context"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+// Pending logins UseCase
+type UseCase interface {
+	CreatePendingLogin(ctx context.Context, login *models.PendingLogin) (string, *models.PendingLogin, error)
+	VerifyPendingLogin(ctx context.Context, token string) (*models.PendingLogin, error)
+	DeletePendingLogin(ctx context.Context, token string) error
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/pending"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/grpc_errors"
+)
+
+const tokenBytes = 32
+
+// Pending logins usecase, short-lived tokens between password and second factor check
+type pendingUseCase struct {
+	pendingRepo pending.RedisRepository
+	expiration  time.Duration
+	maxAttempts int64
+}
+
+// NewPendingUseCase
+func NewPendingUseCase(pendingRepo pending.RedisRepository, expiration time.Duration, maxAttempts int64) *pendingUseCase {
+	return &pendingUseCase{pendingRepo: pendingRepo, expiration: expiration, maxAttempts: maxAttempts}
+}
+
+func (u *pendingUseCase) CreatePendingLogin(ctx context.Context, login *models.PendingLogin) (string, *models.PendingLogin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pendingUseCase.CreatePendingLogin")
+	defer span.Finish()
+
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, errors.Wrap(err, "pendingUseCase.CreatePendingLogin.rand.Read")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	login.CreatedAt = time.Now().UTC()
+	login.ExpiresAt = login.CreatedAt.Add(u.expiration)
+	if err := u.pendingRepo.Create(ctx, hashToken(token), login); err != nil {
+		return "", nil, errors.Wrap(err, "pendingUseCase.CreatePendingLogin.pendingRepo.Create")
+	}
+
+	return token, login, nil
+}
+
+// VerifyPendingLogin every call counts as second factor attempt, token is dropped after max attempts
+func (u *pendingUseCase) VerifyPendingLogin(ctx context.Context, token string) (*models.PendingLogin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pendingUseCase.VerifyPendingLogin")
+	defer span.Finish()
+
+	login, err := u.pendingRepo.GetAndCountAttempt(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, grpc_errors.ErrInvalidPendingToken
+		}
+		return nil, errors.Wrap(err, "pendingUseCase.VerifyPendingLogin.pendingRepo.GetAndCountAttempt")
+	}
+
+	if login.Attempts > u.maxAttempts {
+		if err := u.pendingRepo.Delete(ctx, hashToken(token)); err != nil {
+			return nil, errors.Wrap(err, "pendingUseCase.VerifyPendingLogin.pendingRepo.Delete")
+		}
+		return nil, grpc_errors.ErrInvalidPendingToken
+	}
+
+	return login, nil
+}
+
+func (u *pendingUseCase) DeletePendingLogin(ctx context.Context, token string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pendingUseCase.DeletePendingLogin")
+	defer span.Finish()
+
+	return u.pendingRepo.Delete(ctx, hashToken(token))
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
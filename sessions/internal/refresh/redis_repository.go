@@ -0,0 +1,20 @@
+package refresh
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+// Refresh tokens RedisRepository
+type RedisRepository interface {
+	CreateFamily(ctx context.Context, family *models.RefreshFamily) error
+	FamilyExists(ctx context.Context, familyID string) (bool, error)
+	SaveToken(ctx context.Context, tokenHash string, token *models.RefreshToken) error
+	GetToken(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	MarkUsed(ctx context.Context, tokenHash string) (bool, error)
+	RevokeFamily(ctx context.Context, userID uuid.UUID, familyID string) error
+	RevokeUserFamilies(ctx context.Context, userID uuid.UUID) error
+}
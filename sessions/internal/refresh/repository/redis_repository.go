@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+// refreshRedisRepo, families and tokens live as long as the latest issued token of family
+type refreshRedisRepo struct {
+	redis      *redis.Client
+	prefix     string
+	expiration time.Duration
+}
+
+func NewRefreshRedisRepo(redis *redis.Client, prefix string, expiration time.Duration) *refreshRedisRepo {
+	return &refreshRedisRepo{redis: redis, prefix: prefix, expiration: expiration}
+}
+
+func (r *refreshRedisRepo) CreateFamily(ctx context.Context, family *models.RefreshFamily) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.CreateFamily")
+	defer span.Finish()
+
+	familyBytes, err := json.Marshal(family)
+	if err != nil {
+		return errors.Wrap(err, "refreshRepo.CreateFamily.json.Marshal")
+	}
+
+	if _, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetEX(ctx, r.createFamilyKey(family.FamilyID), string(familyBytes), r.expiration)
+		pipe.SAdd(ctx, r.createUserKey(family.UserID), family.FamilyID)
+		pipe.Expire(ctx, r.createUserKey(family.UserID), r.expiration)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "refreshRepo.CreateFamily.redis.TxPipelined")
+	}
+	return nil
+}
+
+func (r *refreshRedisRepo) FamilyExists(ctx context.Context, familyID string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.FamilyExists")
+	defer span.Finish()
+
+	exists, err := r.redis.Exists(ctx, r.createFamilyKey(familyID)).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "refreshRepo.FamilyExists.redis.Exists")
+	}
+	return exists == 1, nil
+}
+
+// SaveToken store new token of family and extend family expiration
+func (r *refreshRedisRepo) SaveToken(ctx context.Context, tokenHash string, token *models.RefreshToken) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.SaveToken")
+	defer span.Finish()
+
+	tokenBytes, err := json.Marshal(token)
+	if err != nil {
+		return errors.Wrap(err, "refreshRepo.SaveToken.json.Marshal")
+	}
+
+	if _, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetEX(ctx, r.createTokenKey(tokenHash), string(tokenBytes), r.expiration)
+		pipe.Expire(ctx, r.createFamilyKey(token.FamilyID), r.expiration)
+		pipe.Expire(ctx, r.createUserKey(token.UserID), r.expiration)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "refreshRepo.SaveToken.redis.TxPipelined")
+	}
+	return nil
+}
+
+func (r *refreshRedisRepo) GetToken(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.GetToken")
+	defer span.Finish()
+
+	result, err := r.redis.Get(ctx, r.createTokenKey(tokenHash)).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "refreshRepo.GetToken.redis.Get")
+	}
+
+	var token models.RefreshToken
+	if err := json.Unmarshal(result, &token); err != nil {
+		return nil, errors.Wrap(err, "refreshRepo.GetToken.json.Unmarshal")
+	}
+	return &token, nil
+}
+
+// MarkUsed returns false if token was already used, used tokens are kept to detect reuse
+func (r *refreshRedisRepo) MarkUsed(ctx context.Context, tokenHash string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.MarkUsed")
+	defer span.Finish()
+
+	marked, err := r.redis.SetNX(ctx, r.createUsedKey(tokenHash), time.Now().UTC().Unix(), r.expiration).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "refreshRepo.MarkUsed.redis.SetNX")
+	}
+	return marked, nil
+}
+
+func (r *refreshRedisRepo) RevokeFamily(ctx context.Context, userID uuid.UUID, familyID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.RevokeFamily")
+	defer span.Finish()
+
+	if _, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, r.createFamilyKey(familyID))
+		pipe.SRem(ctx, r.createUserKey(userID), familyID)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "refreshRepo.RevokeFamily.redis.TxPipelined")
+	}
+	return nil
+}
+
+func (r *refreshRedisRepo) RevokeUserFamilies(ctx context.Context, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshRedisRepo.RevokeUserFamilies")
+	defer span.Finish()
+
+	familyIDs, err := r.redis.SMembers(ctx, r.createUserKey(userID)).Result()
+	if err != nil {
+		return errors.Wrap(err, "refreshRepo.RevokeUserFamilies.redis.SMembers")
+	}
+
+	keys := make([]string, 0, len(familyIDs)+1)
+	for _, familyID := range familyIDs {
+		keys = append(keys, r.createFamilyKey(familyID))
+	}
+	keys = append(keys, r.createUserKey(userID))
+
+	if err := r.redis.Del(ctx, keys...).Err(); err != nil {
+		return errors.Wrap(err, "refreshRepo.RevokeUserFamilies.redis.Del")
+	}
+	return nil
+}
+
+func (r *refreshRedisRepo) createTokenKey(tokenHash string) string {
+	return fmt.Sprintf("%s: %s", r.prefix, tokenHash)
+}
+
+func (r *refreshRedisRepo) createUsedKey(tokenHash string) string {
+	return fmt.Sprintf("%s_used: %s", r.prefix, tokenHash)
+}
+
+func (r *refreshRedisRepo) createFamilyKey(familyID string) string {
+	return fmt.Sprintf("%s_family: %s", r.prefix, familyID)
+}
+
+func (r *refreshRedisRepo) createUserKey(userID uuid.UUID) string {
+	return fmt.Sprintf("%s_user: %s", r.prefix, userID.String())
+}
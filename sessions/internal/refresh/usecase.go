@@ -0,0 +1,17 @@
+package refresh
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+// Refresh tokens UseCase
+type UseCase interface {
+	CreateRefreshToken(ctx context.Context, family *models.RefreshFamily) (string, *models.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, token string) (string, *models.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, token string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID uuid.UUID) error
+}
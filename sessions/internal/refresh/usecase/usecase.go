@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/refresh"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/grpc_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/logger"
+)
+
+const tokenBytes = 32
+
+// Refresh tokens usecase, every rotation issues new token of the same family,
+// presenting already rotated token revokes whole family
+type refreshUseCase struct {
+	refreshRepo refresh.RedisRepository
+	expiration  time.Duration
+	logger      logger.Logger
+}
+
+// NewRefreshUseCase
+func NewRefreshUseCase(refreshRepo refresh.RedisRepository, expiration time.Duration, logger logger.Logger) *refreshUseCase {
+	return &refreshUseCase{refreshRepo: refreshRepo, expiration: expiration, logger: logger}
+}
+
+// CreateRefreshToken starts new token family
+func (u *refreshUseCase) CreateRefreshToken(ctx context.Context, family *models.RefreshFamily) (string, *models.RefreshToken, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshUseCase.CreateRefreshToken")
+	defer span.Finish()
+
+	family.FamilyID = uuid.NewV4().String()
+	family.CreatedAt = time.Now().UTC()
+
+	if err := u.refreshRepo.CreateFamily(ctx, family); err != nil {
+		return "", nil, errors.Wrap(err, "refreshUseCase.CreateRefreshToken.refreshRepo.CreateFamily")
+	}
+
+	return u.issueToken(ctx, family.FamilyID, family.UserID)
+}
+
+// RotateRefreshToken exchange refresh token for a new one
+func (u *refreshUseCase) RotateRefreshToken(ctx context.Context, token string) (string, *models.RefreshToken, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshUseCase.RotateRefreshToken")
+	defer span.Finish()
+
+	tokenHash := hashToken(token)
+	stored, err := u.refreshRepo.GetToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil, grpc_errors.ErrInvalidRefreshToken
+		}
+		return "", nil, errors.Wrap(err, "refreshUseCase.RotateRefreshToken.refreshRepo.GetToken")
+	}
+
+	marked, err := u.refreshRepo.MarkUsed(ctx, tokenHash)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "refreshUseCase.RotateRefreshToken.refreshRepo.MarkUsed")
+	}
+	if !marked {
+		u.logger.Warnf("refresh token reuse detected, revoking family: %s, user: %s", stored.FamilyID, stored.UserID)
+		if err := u.refreshRepo.RevokeFamily(ctx, stored.UserID, stored.FamilyID); err != nil {
+			return "", nil, errors.Wrap(err, "refreshUseCase.RotateRefreshToken.refreshRepo.RevokeFamily")
+		}
+		return "", nil, grpc_errors.ErrRefreshTokenReused
+	}
+
+	exists, err := u.refreshRepo.FamilyExists(ctx, stored.FamilyID)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "refreshUseCase.RotateRefreshToken.refreshRepo.FamilyExists")
+	}
+	if !exists {
+		return "", nil, grpc_errors.ErrInvalidRefreshToken
+	}
+
+	return u.issueToken(ctx, stored.FamilyID, stored.UserID)
+}
+
+// RevokeRefreshToken revokes family of given token
+func (u *refreshUseCase) RevokeRefreshToken(ctx context.Context, token string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshUseCase.RevokeRefreshToken")
+	defer span.Finish()
+
+	stored, err := u.refreshRepo.GetToken(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return grpc_errors.ErrInvalidRefreshToken
+		}
+		return errors.Wrap(err, "refreshUseCase.RevokeRefreshToken.refreshRepo.GetToken")
+	}
+
+	return u.refreshRepo.RevokeFamily(ctx, stored.UserID, stored.FamilyID)
+}
+
+// RevokeUserRefreshTokens revokes all token families of user
+func (u *refreshUseCase) RevokeUserRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "refreshUseCase.RevokeUserRefreshTokens")
+	defer span.Finish()
+
+	return u.refreshRepo.RevokeUserFamilies(ctx, userID)
+}
+
+func (u *refreshUseCase) issueToken(ctx context.Context, familyID string, userID uuid.UUID) (string, *models.RefreshToken, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, errors.Wrap(err, "refreshUseCase.issueToken.rand.Read")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	stored := &models.RefreshToken{
+		FamilyID:  familyID,
+		UserID:    userID,
+		ExpiresAt: time.Now().UTC().Add(u.expiration),
+	}
+	if err := u.refreshRepo.SaveToken(ctx, hashToken(token), stored); err != nil {
+		return "", nil, errors.Wrap(err, "refreshUseCase.issueToken.refreshRepo.SaveToken")
+	}
+
+	return token, stored, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/config"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/grpc_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/logger"
+)
+
+// memoryRefreshRepo in memory refresh.RedisRepository with the same used token and family semantics
+type memoryRefreshRepo struct {
+	families map[string]*models.RefreshFamily
+	tokens   map[string]*models.RefreshToken
+	used     map[string]bool
+}
+
+func newMemoryRefreshRepo() *memoryRefreshRepo {
+	return &memoryRefreshRepo{
+		families: make(map[string]*models.RefreshFamily),
+		tokens:   make(map[string]*models.RefreshToken),
+		used:     make(map[string]bool),
+	}
+}
+
+func (r *memoryRefreshRepo) CreateFamily(ctx context.Context, family *models.RefreshFamily) error {
+	r.families[family.FamilyID] = family
+	return nil
+}
+
+func (r *memoryRefreshRepo) FamilyExists(ctx context.Context, familyID string) (bool, error) {
+	_, ok := r.families[familyID]
+	return ok, nil
+}
+
+func (r *memoryRefreshRepo) SaveToken(ctx context.Context, tokenHash string, token *models.RefreshToken) error {
+	r.tokens[tokenHash] = token
+	return nil
+}
+
+func (r *memoryRefreshRepo) GetToken(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	token, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, errors.Wrap(redis.Nil, "memoryRefreshRepo.GetToken")
+	}
+	return token, nil
+}
+
+func (r *memoryRefreshRepo) MarkUsed(ctx context.Context, tokenHash string) (bool, error) {
+	if r.used[tokenHash] {
+		return false, nil
+	}
+	r.used[tokenHash] = true
+	return true, nil
+}
+
+func (r *memoryRefreshRepo) RevokeFamily(ctx context.Context, userID uuid.UUID, familyID string) error {
+	delete(r.families, familyID)
+	return nil
+}
+
+func (r *memoryRefreshRepo) RevokeUserFamilies(ctx context.Context, userID uuid.UUID) error {
+	for familyID, family := range r.families {
+		if uuid.Equal(family.UserID, userID) {
+			delete(r.families, familyID)
+		}
+	}
+	return nil
+}
+
+func newTestRefreshUseCase(repo *memoryRefreshRepo) *refreshUseCase {
+	apiLogger := logger.NewApiLogger(&config.Config{Logger: config.Logger{Level: "fatal", Encoding: "console"}})
+	apiLogger.InitLogger()
+	return NewRefreshUseCase(repo, time.Hour, apiLogger)
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		// present returns token presented for rotation and token which must be rejected afterwards
+		present       func(t *testing.T, uc *refreshUseCase) (string, string)
+		wantErr       error
+		wantRejectErr error
+	}{
+		{
+			name: "fresh token rotates, rotated token can't be used twice",
+			present: func(t *testing.T, uc *refreshUseCase) (string, string) {
+				token := createToken(t, uc)
+				return token, ""
+			},
+		},
+		{
+			name: "reused token revokes family including latest token",
+			present: func(t *testing.T, uc *refreshUseCase) (string, string) {
+				first := createToken(t, uc)
+				second := rotateToken(t, uc, first)
+				return first, second
+			},
+			wantErr:       grpc_errors.ErrRefreshTokenReused,
+			wantRejectErr: grpc_errors.ErrInvalidRefreshToken,
+		},
+		{
+			name: "unknown token",
+			present: func(t *testing.T, uc *refreshUseCase) (string, string) {
+				return "unknown", ""
+			},
+			wantErr: grpc_errors.ErrInvalidRefreshToken,
+		},
+		{
+			name: "token of revoked family",
+			present: func(t *testing.T, uc *refreshUseCase) (string, string) {
+				token := createToken(t, uc)
+				if err := uc.RevokeRefreshToken(ctx, token); err != nil {
+					t.Fatalf("RevokeRefreshToken: %v", err)
+				}
+				return token, ""
+			},
+			wantErr: grpc_errors.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestRefreshUseCase(newMemoryRefreshRepo())
+			token, rejected := tt.present(t, uc)
+
+			newToken, _, err := uc.RotateRefreshToken(ctx, token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RotateRefreshToken error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if newToken == "" || newToken == token {
+					t.Fatalf("RotateRefreshToken returned token %q, want new token", newToken)
+				}
+				if _, _, err := uc.RotateRefreshToken(ctx, token); !errors.Is(err, grpc_errors.ErrRefreshTokenReused) {
+					t.Errorf("second RotateRefreshToken error = %v, want %v", err, grpc_errors.ErrRefreshTokenReused)
+				}
+			}
+			if rejected != "" {
+				if _, _, err := uc.RotateRefreshToken(ctx, rejected); !errors.Is(err, tt.wantRejectErr) {
+					t.Errorf("RotateRefreshToken of revoked family error = %v, want %v", err, tt.wantRejectErr)
+				}
+			}
+		})
+	}
+}
+
+func createToken(t *testing.T, uc *refreshUseCase) string {
+	t.Helper()
+	token, _, err := uc.CreateRefreshToken(context.Background(), &models.RefreshFamily{UserID: uuid.NewV4()})
+	if err != nil {
+		t.Fatalf("CreateRefreshToken: %v", err)
+	}
+	return token
+}
+
+func rotateToken(t *testing.T, uc *refreshUseCase, token string) string {
+	t.Helper()
+	newToken, _, err := uc.RotateRefreshToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("RotateRefreshToken: %v", err)
+	}
+	return newToken
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-redis/redis/v8"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -26,6 +27,10 @@ import (
 	crfRepository "github.com/AleksK1NG/hotels-mocroservices/sessions/internal/csrf/repository"
 	csrfUseCase "github.com/AleksK1NG/hotels-mocroservices/sessions/internal/csrf/usecase"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/interceptors"
+	pendingRepository "github.com/AleksK1NG/hotels-mocroservices/sessions/internal/pending/repository"
+	pendingUseCase "github.com/AleksK1NG/hotels-mocroservices/sessions/internal/pending/usecase"
+	refreshRepository "github.com/AleksK1NG/hotels-mocroservices/sessions/internal/refresh/repository"
+	refreshUseCase "github.com/AleksK1NG/hotels-mocroservices/sessions/internal/refresh/usecase"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/session/delivery"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/session/repository"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/session/usecase"
@@ -48,12 +53,27 @@ func NewSessionsServer(logger logger.Logger, cfg *config.Config, redisConn *redi
 
 func (s *Server) Run() error {
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	im := interceptors.NewInterceptorManager(s.logger, s.cfg)
-	sessionRedisRepo := repository.NewSessionRedisRepo(s.redisConn, s.cfg.GRPCServer.SessionPrefix, time.Duration(s.cfg.GRPCServer.SessionExpire)*time.Minute)
-	sessionUC := usecase.NewSessionUseCase(sessionRedisRepo)
+	sessionRedisRepo := repository.NewSessionRedisRepo(
+		s.redisConn,
+		s.cfg.GRPCServer.SessionPrefix,
+		time.Duration(s.cfg.GRPCServer.SessionExpire)*time.Minute,
+		time.Duration(s.cfg.GRPCServer.SessionMaxAge)*time.Minute,
+	)
+	sessionUC := usecase.NewSessionUseCase(sessionRedisRepo, s.logger)
 	csrfRepository := crfRepository.NewCsrfRepository(s.redisConn, s.cfg.GRPCServer.CSRFPrefix, time.Duration(s.cfg.GRPCServer.CsrfExpire)*time.Minute)
-	csrfUC := csrfUseCase.NewCsrfUseCase(csrfRepository)
+	csrfUC, err := csrfUseCase.NewCsrfUseCase(csrfRepository, s.cfg.CSRF)
+	if err != nil {
+		return errors.Wrap(err, "csrfUseCase.NewCsrfUseCase")
+	}
+	refreshExpire := time.Duration(s.cfg.GRPCServer.RefreshTokenExpire) * time.Minute
+	refreshRedisRepo := refreshRepository.NewRefreshRedisRepo(s.redisConn, s.cfg.GRPCServer.RefreshPrefix, refreshExpire)
+	refreshUC := refreshUseCase.NewRefreshUseCase(refreshRedisRepo, refreshExpire, s.logger)
+	pendingExpire := time.Duration(s.cfg.GRPCServer.PendingLoginExpire) * time.Minute
+	pendingRedisRepo := pendingRepository.NewPendingRedisRepo(s.redisConn, s.cfg.GRPCServer.PendingPrefix, pendingExpire)
+	pendingUC := pendingUseCase.NewPendingUseCase(pendingRedisRepo, pendingExpire, s.cfg.GRPCServer.PendingMaxAttempts)
 
 	router := echo.New()
 	router.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
@@ -87,7 +107,7 @@ func (s *Server) Run() error {
 		),
 	)
 
-	sessGRPCService := delivery.NewSessionsService(s.logger, sessionUC, csrfUC)
+	sessGRPCService := delivery.NewSessionsService(s.logger, sessionUC, csrfUC, refreshUC, pendingUC)
 	sessionService.RegisterAuthorizationServiceServer(server, sessGRPCService)
 	grpc_prometheus.Register(server)
 
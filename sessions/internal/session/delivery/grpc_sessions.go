@@ -6,9 +6,12 @@ import (
 	"github.com/opentracing/opentracing-go"
 	uuid "github.com/satori/go.uuid"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/csrf"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/pending"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/refresh"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/session"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/grpc_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/logger"
@@ -16,13 +19,21 @@ import (
 )
 
 type SessionsService struct {
-	logger logger.Logger
-	sessUC session.SessUseCase
-	csrfUC csrf.UseCase
+	logger    logger.Logger
+	sessUC    session.SessUseCase
+	csrfUC    csrf.UseCase
+	refreshUC refresh.UseCase
+	pendingUC pending.UseCase
 }
 
-func NewSessionsService(logger logger.Logger, sessUC session.SessUseCase, csrfUC csrf.UseCase) *SessionsService {
-	return &SessionsService{logger: logger, sessUC: sessUC, csrfUC: csrfUC}
+func NewSessionsService(
+	logger logger.Logger,
+	sessUC session.SessUseCase,
+	csrfUC csrf.UseCase,
+	refreshUC refresh.UseCase,
+	pendingUC pending.UseCase,
+) *SessionsService {
+	return &SessionsService{logger: logger, sessUC: sessUC, csrfUC: csrfUC, refreshUC: refreshUC, pendingUC: pendingUC}
 }
 
 func (s *SessionsService) CreateSession(ctx context.Context, r *sessionService.CreateSessionRequest) (*sessionService.CreateSessionResponse, error) {
@@ -34,7 +45,11 @@ func (s *SessionsService) CreateSession(ctx context.Context, r *sessionService.C
 		s.logger.Errorf("uuid.FromString: %v", err)
 		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "uuid.FromString: %v", err)
 	}
-	sess, err := s.sessUC.CreateSession(ctx, userUUID)
+	sess, err := s.sessUC.CreateSession(ctx, &models.Session{
+		UserID:    userUUID,
+		UserAgent: r.GetUserAgent(),
+		IP:        r.GetIP(),
+	})
 	if err != nil {
 		s.logger.Errorf("sessUC.CreateSession: %v", err)
 		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "sessUC.CreateSession: %v", err)
@@ -53,7 +68,7 @@ func (s *SessionsService) GetSessionByID(ctx context.Context, r *sessionService.
 		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "sessUC.GetSessionByID: %v", err)
 	}
 
-	return &sessionService.GetSessionByIDResponse{Session: s.sessionJSONToProto(sess)}, nil
+	return &sessionService.GetSessionByIDResponse{Session: s.sessionJSONToProto(sess), Renewed: sess.Renewed}, nil
 }
 
 func (s *SessionsService) DeleteSession(ctx context.Context, r *sessionService.DeleteSessionRequest) (*sessionService.DeleteSessionResponse, error) {
@@ -64,9 +79,65 @@ func (s *SessionsService) DeleteSession(ctx context.Context, r *sessionService.D
 		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "sessUC.DeleteSession: %v", err)
 	}
 
+	if err := s.csrfUC.DeleteSessionTokens(ctx, r.SessionID); err != nil {
+		s.logger.Errorf("csrfUC.DeleteSessionTokens: %v", err)
+	}
+
 	return &sessionService.DeleteSessionResponse{SessionID: r.SessionID}, nil
 }
 
+func (s *SessionsService) ListUserSessions(ctx context.Context, r *sessionService.ListUserSessionsRequest) (*sessionService.ListUserSessionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.ListUserSessions")
+	defer span.Finish()
+
+	userUUID, err := uuid.FromString(r.GetUserID())
+	if err != nil {
+		s.logger.Errorf("uuid.FromString: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "uuid.FromString: %v", err)
+	}
+
+	sessions, err := s.sessUC.ListUserSessions(ctx, userUUID)
+	if err != nil {
+		s.logger.Errorf("sessUC.ListUserSessions: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "sessUC.ListUserSessions: %v", err)
+	}
+
+	res := make([]*sessionService.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		res = append(res, s.sessionJSONToProto(sess))
+	}
+
+	return &sessionService.ListUserSessionsResponse{Sessions: res}, nil
+}
+
+func (s *SessionsService) RevokeAllUserSessions(ctx context.Context, r *sessionService.RevokeAllUserSessionsRequest) (*sessionService.RevokeAllUserSessionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.RevokeAllUserSessions")
+	defer span.Finish()
+
+	userUUID, err := uuid.FromString(r.GetUserID())
+	if err != nil {
+		s.logger.Errorf("uuid.FromString: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "uuid.FromString: %v", err)
+	}
+
+	revoked, err := s.sessUC.RevokeAllUserSessions(ctx, userUUID, r.GetExceptSessionID())
+	if err != nil {
+		s.logger.Errorf("sessUC.RevokeAllUserSessions: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "sessUC.RevokeAllUserSessions: %v", err)
+	}
+
+	if err := s.csrfUC.DeleteSessionTokens(ctx, revoked...); err != nil {
+		s.logger.Errorf("csrfUC.DeleteSessionTokens: %v", err)
+	}
+
+	if err := s.refreshUC.RevokeUserRefreshTokens(ctx, userUUID); err != nil {
+		s.logger.Errorf("refreshUC.RevokeUserRefreshTokens: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "refreshUC.RevokeUserRefreshTokens: %v", err)
+	}
+
+	return &sessionService.RevokeAllUserSessionsResponse{UserID: userUUID.String(), Revoked: int64(len(revoked))}, nil
+}
+
 func (s *SessionsService) CreateCsrfToken(ctx context.Context, r *sessionService.CreateCsrfTokenRequest) (*sessionService.CreateCsrfTokenResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.CreateCsrfToken")
 	defer span.Finish()
@@ -91,9 +162,124 @@ func (s *SessionsService) CheckCsrfToken(ctx context.Context, r *sessionService.
 	return &sessionService.CheckCsrfTokenResponse{CheckResult: &sessionService.CheckResult{Result: isValid}}, nil
 }
 
+func (s *SessionsService) CreateRefreshToken(ctx context.Context, r *sessionService.CreateRefreshTokenRequest) (*sessionService.CreateRefreshTokenResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.CreateRefreshToken")
+	defer span.Finish()
+
+	userUUID, err := uuid.FromString(r.GetUserID())
+	if err != nil {
+		s.logger.Errorf("uuid.FromString: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "uuid.FromString: %v", err)
+	}
+
+	token, stored, err := s.refreshUC.CreateRefreshToken(ctx, &models.RefreshFamily{
+		UserID:    userUUID,
+		UserAgent: r.GetUserAgent(),
+		IP:        r.GetIP(),
+	})
+	if err != nil {
+		s.logger.Errorf("refreshUC.CreateRefreshToken: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "refreshUC.CreateRefreshToken: %v", err)
+	}
+
+	return &sessionService.CreateRefreshTokenResponse{
+		RefreshToken: token,
+		FamilyID:     stored.FamilyID,
+		ExpiresAt:    timestamppb.New(stored.ExpiresAt),
+	}, nil
+}
+
+func (s *SessionsService) RotateRefreshToken(ctx context.Context, r *sessionService.RotateRefreshTokenRequest) (*sessionService.RotateRefreshTokenResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.RotateRefreshToken")
+	defer span.Finish()
+
+	token, stored, err := s.refreshUC.RotateRefreshToken(ctx, r.GetRefreshToken())
+	if err != nil {
+		s.logger.Errorf("refreshUC.RotateRefreshToken: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "refreshUC.RotateRefreshToken: %v", err)
+	}
+
+	return &sessionService.RotateRefreshTokenResponse{
+		RefreshToken: token,
+		FamilyID:     stored.FamilyID,
+		UserID:       stored.UserID.String(),
+		ExpiresAt:    timestamppb.New(stored.ExpiresAt),
+	}, nil
+}
+
+func (s *SessionsService) RevokeRefreshToken(ctx context.Context, r *sessionService.RevokeRefreshTokenRequest) (*sessionService.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.RevokeRefreshToken")
+	defer span.Finish()
+
+	if err := s.refreshUC.RevokeRefreshToken(ctx, r.GetRefreshToken()); err != nil {
+		s.logger.Errorf("refreshUC.RevokeRefreshToken: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "refreshUC.RevokeRefreshToken: %v", err)
+	}
+
+	return &sessionService.Empty{}, nil
+}
+
+func (s *SessionsService) CreatePendingLogin(ctx context.Context, r *sessionService.CreatePendingLoginRequest) (*sessionService.CreatePendingLoginResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.CreatePendingLogin")
+	defer span.Finish()
+
+	userUUID, err := uuid.FromString(r.GetUserID())
+	if err != nil {
+		s.logger.Errorf("uuid.FromString: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "uuid.FromString: %v", err)
+	}
+
+	token, login, err := s.pendingUC.CreatePendingLogin(ctx, &models.PendingLogin{
+		UserID:    userUUID,
+		UserAgent: r.GetUserAgent(),
+		IP:        r.GetIP(),
+	})
+	if err != nil {
+		s.logger.Errorf("pendingUC.CreatePendingLogin: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "pendingUC.CreatePendingLogin: %v", err)
+	}
+
+	return &sessionService.CreatePendingLoginResponse{PendingToken: token, ExpiresAt: timestamppb.New(login.ExpiresAt)}, nil
+}
+
+func (s *SessionsService) VerifyPendingLogin(ctx context.Context, r *sessionService.VerifyPendingLoginRequest) (*sessionService.VerifyPendingLoginResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.VerifyPendingLogin")
+	defer span.Finish()
+
+	login, err := s.pendingUC.VerifyPendingLogin(ctx, r.GetPendingToken())
+	if err != nil {
+		s.logger.Errorf("pendingUC.VerifyPendingLogin: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "pendingUC.VerifyPendingLogin: %v", err)
+	}
+
+	return &sessionService.VerifyPendingLoginResponse{
+		UserID:    login.UserID.String(),
+		UserAgent: login.UserAgent,
+		IP:        login.IP,
+		Attempts:  login.Attempts,
+	}, nil
+}
+
+func (s *SessionsService) DeletePendingLogin(ctx context.Context, r *sessionService.DeletePendingLoginRequest) (*sessionService.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SessionsService.DeletePendingLogin")
+	defer span.Finish()
+
+	if err := s.pendingUC.DeletePendingLogin(ctx, r.GetPendingToken()); err != nil {
+		s.logger.Errorf("pendingUC.DeletePendingLogin: %v", err)
+		return nil, status.Errorf(grpc_errors.ParseGRPCErrStatusCode(err), "pendingUC.DeletePendingLogin: %v", err)
+	}
+
+	return &sessionService.Empty{}, nil
+}
+
 func (s *SessionsService) sessionJSONToProto(sess *models.Session) *sessionService.Session {
 	return &sessionService.Session{
-		UserID:    sess.UserID.String(),
-		SessionID: sess.SessionID,
+		UserID:     sess.UserID.String(),
+		SessionID:  sess.SessionID,
+		UserAgent:  sess.UserAgent,
+		IP:         sess.IP,
+		CreatedAt:  timestamppb.New(sess.CreatedAt),
+		LastSeenAt: timestamppb.New(sess.LastSeenAt),
+		ExpiresAt:  timestamppb.New(sess.ExpiresAt),
 	}
 }
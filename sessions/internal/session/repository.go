@@ -10,7 +10,10 @@ import (
 
 // Session RedisRepository
 type RedisRepository interface {
-	CreateSession(ctx context.Context, userID uuid.UUID) (*models.Session, error)
+	CreateSession(ctx context.Context, sess *models.Session) (*models.Session, error)
 	GetSessionByID(ctx context.Context, sessID string) (*models.Session, error)
+	RenewSession(ctx context.Context, sess *models.Session) error
 	DeleteSession(ctx context.Context, sessID string) error
+	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error)
+	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID, exceptSessionID string) ([]string, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -14,33 +15,46 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
 )
 
+const (
+	renewThreshold = 0.5
+)
+
 // sessionRedisRepo
+// expiration is sliding idle timeout, maxAge is absolute session lifetime
 type sessionRedisRepo struct {
 	redis      *redis.Client
 	prefix     string
 	expiration time.Duration
+	maxAge     time.Duration
 }
 
-func NewSessionRedisRepo(redis *redis.Client, prefix string, expiration time.Duration) *sessionRedisRepo {
-	return &sessionRedisRepo{redis: redis, prefix: prefix, expiration: expiration}
+func NewSessionRedisRepo(redis *redis.Client, prefix string, expiration time.Duration, maxAge time.Duration) *sessionRedisRepo {
+	return &sessionRedisRepo{redis: redis, prefix: prefix, expiration: expiration, maxAge: maxAge}
 }
 
-func (s *sessionRedisRepo) CreateSession(ctx context.Context, userID uuid.UUID) (*models.Session, error) {
+func (s *sessionRedisRepo) CreateSession(ctx context.Context, sess *models.Session) (*models.Session, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionRedisRepo.CreateSession")
 	defer span.Finish()
 
-	sess := &models.Session{
-		SessionID: uuid.NewV4().String(),
-		UserID:    userID,
-	}
+	now := time.Now().UTC()
+	sess.SessionID = uuid.NewV4().String()
+	sess.CreatedAt = now
+	sess.LastSeenAt = now
+	sess.ExpiresAt = s.getExpiresAt(sess, now)
 
 	sessBytes, err := json.Marshal(&sess)
 	if err != nil {
 		return nil, errors.Wrap(err, "sessionRepo.CreateSession.json.Marshal")
 	}
 
-	if err := s.redis.SetEX(ctx, s.createKey(sess.SessionID), string(sessBytes), s.expiration).Err(); err != nil {
-		return nil, errors.Wrap(err, "sessionRepo.CreateSession.redis.SetEX")
+	// user sessions index lives as long as the latest user session
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetEX(ctx, s.createKey(sess.SessionID), string(sessBytes), sess.ExpiresAt.Sub(now))
+		pipe.SAdd(ctx, s.createUserKey(sess.UserID), sess.SessionID)
+		pipe.Expire(ctx, s.createUserKey(sess.UserID), s.expiration)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "sessionRepo.CreateSession.redis.TxPipelined")
 	}
 
 	return sess, nil
@@ -59,19 +73,181 @@ func (s *sessionRedisRepo) GetSessionByID(ctx context.Context, sessID string) (*
 	if err := json.Unmarshal([]byte(result), &sess); err != nil {
 		return nil, errors.Wrap(err, "sessionRepo.GetSessionByID.json.Unmarshal")
 	}
+	sess.SessionID = sessID
 	return &sess, nil
 }
 
+// RenewSession update last seen time and slide expiration, never beyond session max age
+func (s *sessionRedisRepo) RenewSession(ctx context.Context, sess *models.Session) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionRedisRepo.RenewSession")
+	defer span.Finish()
+
+	now := time.Now().UTC()
+	if sess.CreatedAt.IsZero() {
+		// sessions stored before max age was introduced, max age counts from first renewal instead of logging them out
+		sess.CreatedAt = now
+	}
+	expiresAt := s.getRenewedExpiresAt(sess, now)
+	ttl := expiresAt.Sub(now)
+	if ttl <= 0 {
+		return errors.Wrap(redis.Nil, "sessionRepo.RenewSession session max age exceeded")
+	}
+
+	sess.Renewed = expiresAt.After(sess.ExpiresAt)
+	sess.LastSeenAt = now
+	sess.ExpiresAt = expiresAt
+
+	sessBytes, err := json.Marshal(&sess)
+	if err != nil {
+		return errors.Wrap(err, "sessionRepo.RenewSession.json.Marshal")
+	}
+
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetXX(ctx, s.createKey(sess.SessionID), string(sessBytes), ttl)
+		pipe.Expire(ctx, s.createUserKey(sess.UserID), s.expiration)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "sessionRepo.RenewSession.redis.TxPipelined")
+	}
+	return nil
+}
+
 func (s *sessionRedisRepo) DeleteSession(ctx context.Context, sessID string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionRedisRepo.DeleteSession")
 	defer span.Finish()
 
-	if err := s.redis.Del(ctx, s.createKey(sessID)).Err(); err != nil {
-		return errors.Wrap(err, "sessionRepo.DeleteSession.redis.Del")
+	sess, err := s.GetSessionByID(ctx, sessID)
+	if err != nil {
+		if errors.Cause(err) == redis.Nil {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, s.createKey(sessID))
+		pipe.SRem(ctx, s.createUserKey(sess.UserID), sessID)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "sessionRepo.DeleteSession.redis.TxPipelined")
 	}
 	return nil
 }
 
+// ListUserSessions active sessions of the user, expired sessions are removed from index
+func (s *sessionRedisRepo) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionRedisRepo.ListUserSessions")
+	defer span.Finish()
+
+	sessIDs, err := s.redis.SMembers(ctx, s.createUserKey(userID)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "sessionRepo.ListUserSessions.redis.SMembers")
+	}
+	if len(sessIDs) == 0 {
+		return make([]*models.Session, 0), nil
+	}
+
+	keys := make([]string, 0, len(sessIDs))
+	for _, sessID := range sessIDs {
+		keys = append(keys, s.createKey(sessID))
+	}
+
+	values, err := s.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "sessionRepo.ListUserSessions.redis.MGet")
+	}
+
+	sessions := make([]*models.Session, 0, len(values))
+	expired := make([]interface{}, 0)
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			expired = append(expired, sessIDs[i])
+			continue
+		}
+		var sess models.Session
+		if err := json.Unmarshal([]byte(data), &sess); err != nil {
+			return nil, errors.Wrap(err, "sessionRepo.ListUserSessions.json.Unmarshal")
+		}
+		sess.SessionID = sessIDs[i]
+		sessions = append(sessions, &sess)
+	}
+
+	if len(expired) > 0 {
+		if err := s.redis.SRem(ctx, s.createUserKey(userID), expired...).Err(); err != nil {
+			return nil, errors.Wrap(err, "sessionRepo.ListUserSessions.redis.SRem")
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// RevokeAllUserSessions revoke every session of the user except given one, returns revoked sessions ids
+func (s *sessionRedisRepo) RevokeAllUserSessions(ctx context.Context, userID uuid.UUID, exceptSessionID string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionRedisRepo.RevokeAllUserSessions")
+	defer span.Finish()
+
+	sessIDs, err := s.redis.SMembers(ctx, s.createUserKey(userID)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "sessionRepo.RevokeAllUserSessions.redis.SMembers")
+	}
+
+	revoked := make([]string, 0, len(sessIDs))
+	keys := make([]string, 0, len(sessIDs))
+	members := make([]interface{}, 0, len(sessIDs))
+	for _, sessID := range sessIDs {
+		if sessID == exceptSessionID {
+			continue
+		}
+		revoked = append(revoked, sessID)
+		keys = append(keys, s.createKey(sessID))
+		members = append(members, sessID)
+	}
+	if len(keys) == 0 {
+		return revoked, nil
+	}
+
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.SRem(ctx, s.createUserKey(userID), members...)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "sessionRepo.RevokeAllUserSessions.redis.TxPipelined")
+	}
+	return revoked, nil
+}
+
+// getExpiresAt sliding expiration capped by absolute session max age, zero created at counts as now
+func (s *sessionRedisRepo) getExpiresAt(sess *models.Session, now time.Time) time.Time {
+	createdAt := sess.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+
+	expiresAt := now.Add(s.expiration)
+	if maxExpiresAt := createdAt.Add(s.maxAge); s.maxAge > 0 && expiresAt.After(maxExpiresAt) {
+		return maxExpiresAt
+	}
+	return expiresAt
+}
+
+// getRenewedExpiresAt slide expiration only when less than renewThreshold of idle window remains,
+// so session and its cookie are not reissued on every renewal
+func (s *sessionRedisRepo) getRenewedExpiresAt(sess *models.Session, now time.Time) time.Time {
+	if sess.ExpiresAt.Sub(now) > time.Duration(float64(s.expiration)*renewThreshold) {
+		return sess.ExpiresAt
+	}
+	return s.getExpiresAt(sess, now)
+}
+
 func (s *sessionRedisRepo) createKey(sessionID string) string {
 	return fmt.Sprintf("%s: %s", s.prefix, sessionID)
 }
+
+func (s *sessionRedisRepo) createUserKey(userID uuid.UUID) string {
+	return fmt.Sprintf("%s_user: %s", s.prefix, userID.String())
+}
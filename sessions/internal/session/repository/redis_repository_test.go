@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
+)
+
+func TestGetRenewedExpiresAt(t *testing.T) {
+	const (
+		expiration = 60 * time.Minute
+		maxAge     = 24 * time.Hour
+	)
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		sess *models.Session
+		want time.Time
+	}{
+		{
+			name: "most of idle window left keeps expiration",
+			sess: &models.Session{CreatedAt: now.Add(-time.Hour), ExpiresAt: now.Add(50 * time.Minute)},
+			want: now.Add(50 * time.Minute),
+		},
+		{
+			name: "less than half of idle window left slides expiration",
+			sess: &models.Session{CreatedAt: now.Add(-time.Hour), ExpiresAt: now.Add(20 * time.Minute)},
+			want: now.Add(expiration),
+		},
+		{
+			name: "slide capped by max age",
+			sess: &models.Session{CreatedAt: now.Add(-maxAge + 10*time.Minute), ExpiresAt: now.Add(5 * time.Minute)},
+			want: now.Add(10 * time.Minute),
+		},
+		{
+			name: "session without created at and expires at is not logged out",
+			sess: &models.Session{},
+			want: now.Add(expiration),
+		},
+	}
+
+	repo := &sessionRedisRepo{expiration: expiration, maxAge: maxAge}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := repo.getRenewedExpiresAt(tt.sess, now); !got.Equal(tt.want) {
+				t.Errorf("getRenewedExpiresAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,7 +10,9 @@ import (
 
 // Session SessUseCase
 type SessUseCase interface {
-	CreateSession(ctx context.Context, userID uuid.UUID) (*models.Session, error)
+	CreateSession(ctx context.Context, sess *models.Session) (*models.Session, error)
 	GetSessionByID(ctx context.Context, sessID string) (*models.Session, error)
 	DeleteSession(ctx context.Context, sessID string) error
+	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error)
+	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID, exceptSessionID string) ([]string, error)
 }
@@ -2,32 +2,64 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/sessions/internal/session"
+	"github.com/AleksK1NG/hotels-mocroservices/sessions/pkg/logger"
+)
+
+const (
+	renewInterval = time.Minute
 )
 
 type sessionUseCase struct {
 	sessRepo session.RedisRepository
+	logger   logger.Logger
 }
 
-func NewSessionUseCase(sessRepo session.RedisRepository) *sessionUseCase {
-	return &sessionUseCase{sessRepo: sessRepo}
+func NewSessionUseCase(sessRepo session.RedisRepository, logger logger.Logger) *sessionUseCase {
+	return &sessionUseCase{sessRepo: sessRepo, logger: logger}
 }
 
-func (s *sessionUseCase) CreateSession(ctx context.Context, userID uuid.UUID) (*models.Session, error) {
+func (s *sessionUseCase) CreateSession(ctx context.Context, sess *models.Session) (*models.Session, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionUseCase.CreateSession")
 	defer span.Finish()
-	return s.sessRepo.CreateSession(ctx, userID)
+	return s.sessRepo.CreateSession(ctx, sess)
 }
 
+// GetSessionByID returns session and renews its expiration, at most once per renewInterval
 func (s *sessionUseCase) GetSessionByID(ctx context.Context, sessID string) (*models.Session, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionUseCase.GetSessionByID")
 	defer span.Finish()
-	return s.sessRepo.GetSessionByID(ctx, sessID)
+
+	sess, err := s.sessRepo.GetSessionByID(ctx, sessID)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Since(sess.LastSeenAt) > renewInterval {
+		if err := s.sessRepo.RenewSession(ctx, sess); err != nil {
+			s.logger.Errorf("sessRepo.RenewSession: %v", err)
+		}
+	}
+
+	return sess, nil
+}
+
+func (s *sessionUseCase) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionUseCase.ListUserSessions")
+	defer span.Finish()
+	return s.sessRepo.ListUserSessions(ctx, userID)
+}
+
+func (s *sessionUseCase) RevokeAllUserSessions(ctx context.Context, userID uuid.UUID, exceptSessionID string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "sessionUseCase.RevokeAllUserSessions")
+	defer span.Finish()
+	return s.sessRepo.RevokeAllUserSessions(ctx, userID, exceptSessionID)
 }
 
 func (s *sessionUseCase) DeleteSession(ctx context.Context, sessID string) error {
@@ -16,6 +16,10 @@ var (
 	ErrNoCtxMetaData    = errors.New("No ctx metadata")
 	ErrInvalidSessionId = errors.New("Invalid session id")
 	ErrEmailExists      = errors.New("Email already exists")
+
+	ErrInvalidRefreshToken = errors.New("Invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("Refresh token reused")
+	ErrInvalidPendingToken = errors.New("Invalid pending login token")
 )
 
 // Parse error and get code
@@ -33,6 +37,8 @@ func ParseGRPCErrStatusCode(err error) codes.Code {
 		return codes.AlreadyExists
 	case errors.Is(err, ErrNoCtxMetaData):
 		return codes.Unauthenticated
+	case errors.Is(err, ErrInvalidRefreshToken), errors.Is(err, ErrRefreshTokenReused), errors.Is(err, ErrInvalidPendingToken):
+		return codes.Unauthenticated
 	case errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
 	case strings.Contains(err.Error(), "Validate"):
@@ -9,16 +9,25 @@ import (
 
 // App config
 type Config struct {
-	GRPCServer GRPCServer
-	HttpServer HttpServer
-	Postgres   PostgresConfig
-	Redis      RedisConfig
-	Metrics    Metrics
-	Logger     Logger
-	Jaeger     Jaeger
-	RabbitMQ   RabbitMQ
+	GRPCServer        GRPCServer
+	HttpServer        HttpServer
+	Postgres          PostgresConfig
+	Redis             RedisConfig
+	Metrics           Metrics
+	Logger            Logger
+	Jaeger            Jaeger
+	RabbitMQ          RabbitMQ
+	Mailer            Mailer
+	PasswordReset     PasswordReset
+	EmailVerification EmailVerification
+	JWT               JWT
+	OIDC              OIDC
+	TwoFactor         TwoFactor
+	LoginProtection   LoginProtection
 }
 
+// HttpServer config, TrustedProxies are CIDR ranges allowed to set X-Forwarded-For,
+// client address of connection is used when empty, CookieSecure is disabled only for local development over http
 type HttpServer struct {
 	Port              string
 	PprofPort         string
@@ -27,6 +36,10 @@ type HttpServer struct {
 	WriteTimeout      time.Duration
 	CookieLifeTime    int
 	SessionCookieName string
+	CookieSecure      bool
+	CSRFHeader        string
+	CSRFAllowlist     []string
+	TrustedProxies    []string
 }
 
 // RabbitMQ
@@ -40,6 +53,88 @@ type RabbitMQ struct {
 	RoutingKey     string
 	ConsumerTag    string
 	WorkerPoolSize int
+	DrainTimeout   time.Duration
+
+	AvatarsConsumer RabbitMQConsumer
+}
+
+// RabbitMQConsumer worker pool and prefetch config of single queue consumer
+type RabbitMQConsumer struct {
+	WorkerPoolSize int
+	PrefetchCount  int
+}
+
+// Mailer config, driver is smtp or log
+type Mailer struct {
+	Driver   string
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	FilePath string
+}
+
+// PasswordReset config, token TTL in seconds
+type PasswordReset struct {
+	TokenTTL    time.Duration
+	TokenPrefix string
+	ResetURL    string
+}
+
+// EmailVerification config, token TTL in seconds
+type EmailVerification struct {
+	TokenTTL    time.Duration
+	TokenPrefix string
+	VerifyURL   string
+}
+
+// JWT access tokens config, token TTL in seconds
+type JWT struct {
+	Secret         string
+	Issuer         string
+	AccessTokenTTL time.Duration
+}
+
+// OIDC social login config, state TTL in seconds
+type OIDC struct {
+	StateTTL        time.Duration
+	StatePrefix     string
+	StateCookieName string
+	Providers       []OIDCProvider
+}
+
+// OIDCProvider OpenID Connect provider client registration
+type OIDCProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// TwoFactor TOTP config, encryption key is hex encoded 32 bytes,
+// admins can use only allowlisted routes until they enroll
+type TwoFactor struct {
+	Issuer              string
+	EncryptionKey       string
+	RecoveryCodesCount  int
+	EnrollmentAllowlist []string
+}
+
+// LoginProtection brute force protection config, window, delays and lockout in seconds,
+// email is throttled for delay doubling with each failure after DelayAfter up to MaxDelay,
+// attempts during throttle or lockout are rejected with 429 and Retry-After
+type LoginProtection struct {
+	Prefix           string
+	Window           time.Duration
+	MaxEmailFailures int64
+	MaxIPFailures    int64
+	DelayAfter       int64
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+	LockoutDuration  time.Duration
 }
 
 // GRPCServer config
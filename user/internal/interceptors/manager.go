@@ -5,11 +5,15 @@ import (
 	"time"
 
 	"github.com/opentracing/opentracing-go"
+	uuid "github.com/satori/go.uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/grpc_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/rbac"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/utils"
 )
 
 // InterceptorManager
@@ -34,6 +38,39 @@ func (im *InterceptorManager) Logger(ctx context.Context, req interface{}, info
 	return reply, err
 }
 
+// RoleLoader load current role of user by id, roles are never taken from request metadata
+type RoleLoader func(ctx context.Context, userID uuid.UUID) (string, error)
+
+// Permissions deny listed methods when role of request metadata user doesn't grant method permissions,
+// not listed methods are passed through
+func (im *InterceptorManager) Permissions(methodPermissions map[string][]rbac.Permission, loadRole RoleLoader) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		permissions, ok := methodPermissions[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		userID, err := utils.GetUserIDFromCtx(ctx)
+		if err != nil {
+			im.logger.Errorf("Permissions method: %s GetUserIDFromCtx: %v", info.FullMethod, err)
+			return nil, grpc_errors.ErrorResponse(err, "GetUserIDFromCtx")
+		}
+
+		role, err := loadRole(ctx, userID)
+		if err != nil {
+			im.logger.Errorf("Permissions method: %s loadRole: %v", info.FullMethod, err)
+			return nil, grpc_errors.ErrorResponse(err, "loadRole")
+		}
+
+		if !rbac.HasPermission(role, permissions...) {
+			im.logger.Warnf("Permissions method: %s role: %s permissions: %v denied", info.FullMethod, role, permissions)
+			return nil, grpc_errors.ErrorResponse(grpc_errors.ErrPermissionDenied, info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
 // GetInterceptor
 func (im *InterceptorManager) GetInterceptor() func(
 	ctx context.Context,
@@ -9,21 +9,28 @@ import (
 	"github.com/opentracing/opentracing-go"
 
 	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/rbac"
 )
 
 // MiddlewareManager
 type MiddlewareManager struct {
-	logger logger.Logger
-	cfg    *config.Config
-	userUC user.UseCase
+	logger              logger.Logger
+	cfg                 *config.Config
+	userUC              user.UseCase
+	enrollmentAllowlist map[string]struct{}
 }
 
 // NewMiddlewareManager
 func NewMiddlewareManager(logger logger.Logger, cfg *config.Config, userUC user.UseCase) *MiddlewareManager {
-	return &MiddlewareManager{logger: logger, cfg: cfg, userUC: userUC}
+	enrollmentAllowlist := make(map[string]struct{}, len(cfg.TwoFactor.EnrollmentAllowlist))
+	for _, path := range cfg.TwoFactor.EnrollmentAllowlist {
+		enrollmentAllowlist[path] = struct{}{}
+	}
+	return &MiddlewareManager{logger: logger, cfg: cfg, userUC: userUC, enrollmentAllowlist: enrollmentAllowlist}
 }
 
 // Request Ctx User key
@@ -60,6 +67,21 @@ func (m *MiddlewareManager) SessionMiddleware(next echo.HandlerFunc) echo.Handle
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
+		if userResponse.IsSuspended() {
+			m.logger.Warnf("SessionMiddleware suspended user: %s", userResponse.UserID.String())
+			return httpErrors.ErrorCtxResponse(c, httpErrors.AccountSuspended)
+		}
+
+		if userResponse.IsAdmin() && !userResponse.IsTwoFactorEnabled() {
+			if _, ok := m.enrollmentAllowlist[c.Path()]; !ok {
+				return httpErrors.ErrorCtxResponse(c, httpErrors.TwoFactorEnrollmentRequired)
+			}
+		}
+
+		if sessionByID.Renewed {
+			m.SetSessionCookie(c, sessionByID)
+		}
+
 		ctx = context.WithValue(c.Request().Context(), RequestCtxUser{}, userResponse)
 		ctx = context.WithValue(ctx, RequestCtxSession{}, sessionByID)
 		c.SetRequest(c.Request().WithContext(ctx))
@@ -67,3 +89,90 @@ func (m *MiddlewareManager) SessionMiddleware(next echo.HandlerFunc) echo.Handle
 		return next(c)
 	}
 }
+
+// RequirePermission allow only users whose role grants all given permissions, must be used after SessionMiddleware
+func (m *MiddlewareManager) RequirePermission(permissions ...rbac.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userResponse, ok := c.Request().Context().Value(RequestCtxUser{}).(*models.UserResponse)
+			if !ok {
+				m.logger.Error("RequirePermission invalid middleware user ctx")
+				return httpErrors.ErrorCtxResponse(c, httpErrors.Unauthorized)
+			}
+
+			if !userResponse.HasPermission(permissions...) {
+				m.logger.Warnf("RequirePermission user: %s permissions: %v denied", userResponse.UserID.String(), permissions)
+				return httpErrors.ErrorCtxResponse(c, httpErrors.PermissionDenied)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// SetSessionCookie set session cookie expiring with the server side session
+func (m *MiddlewareManager) SetSessionCookie(c echo.Context, sess *models.Session) {
+	c.SetCookie(&http.Cookie{
+		Name:     m.cfg.HttpServer.SessionCookieName,
+		Value:    sess.SessionID,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   m.cfg.HttpServer.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  sess.ExpiresAt,
+	})
+}
+
+// ClearSessionCookie expire session cookie with the same attributes it was set with
+func (m *MiddlewareManager) ClearSessionCookie(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     m.cfg.HttpServer.SessionCookieName,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   m.cfg.HttpServer.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+	})
+}
+
+// CSRFMiddleware validate csrf token of session on unsafe methods, allowlisted routes are skipped
+func (m *MiddlewareManager) CSRFMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	allowlist := make(map[string]struct{}, len(m.cfg.HttpServer.CSRFAllowlist))
+	for _, path := range m.cfg.HttpServer.CSRFAllowlist {
+		allowlist[path] = struct{}{}
+	}
+
+	return func(c echo.Context) error {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+			return next(c)
+		}
+		if _, ok := allowlist[c.Path()]; ok {
+			return next(c)
+		}
+
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.CSRFMiddleware")
+		defer span.Finish()
+
+		token := c.Request().Header.Get(m.cfg.HttpServer.CSRFHeader)
+		if token == "" {
+			m.logger.Errorf("CSRFMiddleware token not presented")
+			return httpErrors.ErrorCtxResponse(c, httpErrors.CSRFNotPresented)
+		}
+
+		cookie, err := c.Cookie(m.cfg.HttpServer.SessionCookieName)
+		if err != nil {
+			m.logger.Errorf("CSRFMiddleware.c.Cookie: %v", err)
+			return httpErrors.ErrorCtxResponse(c, httpErrors.Unauthorized)
+		}
+
+		isValid, err := m.userUC.CheckCSRFToken(ctx, cookie.Value, token)
+		if err != nil || !isValid {
+			m.logger.Errorf("CSRFMiddleware.CheckCSRFToken: %v", err)
+			return httpErrors.ErrorCtxResponse(c, httpErrors.WrongCSRFToken)
+		}
+
+		return next(c)
+	}
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userService "github.com/AleksK1NG/hotels-mocroservices/user/proto/user"
+)
+
+const (
+	defaultUsersPageSize = 10
+
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+	UserStatusDeleted   = "deleted"
+)
+
+// AdminUser user with account management state, admin only
+type AdminUser struct {
+	UserResponse
+	SuspensionReason *string    `json:"suspension_reason"`
+	DeletedAt        *time.Time `json:"deleted_at"`
+}
+
+// ToProto
+func (u *AdminUser) ToProto() *userService.AdminUser {
+	res := &userService.AdminUser{User: u.UserResponse.ToProto()}
+	if u.SuspensionReason != nil {
+		res.SuspensionReason = *u.SuspensionReason
+	}
+	if u.DeletedAt != nil {
+		res.DeletedAt = timestamppb.New(*u.DeletedAt)
+	}
+	return res
+}
+
+// UsersQuery admin users list query, search matches email, first or last name
+type UsersQuery struct {
+	Page   int    `query:"page" validate:"omitempty,gte=1"`
+	Size   int    `query:"size" validate:"omitempty,gte=1,lte=100"`
+	Search string `query:"search" validate:"max=64"`
+	Role   string `query:"role" validate:"omitempty,oneof=admin user owner"`
+	Status string `query:"status" validate:"omitempty,oneof=active suspended deleted"`
+}
+
+// GetPage page number, first page by default
+func (q *UsersQuery) GetPage() int {
+	if q.Page < 1 {
+		return 1
+	}
+	return q.Page
+}
+
+// GetSize page size, default size if not set
+func (q *UsersQuery) GetSize() int {
+	if q.Size < 1 {
+		return defaultUsersPageSize
+	}
+	return q.Size
+}
+
+// GetOffset
+func (q *UsersQuery) GetOffset() int {
+	return (q.GetPage() - 1) * q.GetSize()
+}
+
+// UsersList admin users list page
+type UsersList struct {
+	TotalCount int          `json:"total_count"`
+	TotalPages int          `json:"total_pages"`
+	Page       int          `json:"page"`
+	Size       int          `json:"size"`
+	HasMore    bool         `json:"has_more"`
+	Users      []*AdminUser `json:"users"`
+}
+
+// NewUsersList fill pagination fields of users page
+func NewUsersList(query *UsersQuery, totalCount int, users []*AdminUser) *UsersList {
+	return &UsersList{
+		TotalCount: totalCount,
+		TotalPages: int(math.Ceil(float64(totalCount) / float64(query.GetSize()))),
+		Page:       query.GetPage(),
+		Size:       query.GetSize(),
+		HasMore:    query.GetOffset()+len(users) < totalCount,
+		Users:      users,
+	}
+}
+
+// ToProto
+func (l *UsersList) ToProto() *userService.ListUsersRes {
+	users := make([]*userService.AdminUser, 0, len(l.Users))
+	for _, user := range l.Users {
+		users = append(users, user.ToProto())
+	}
+	return &userService.ListUsersRes{
+		TotalCount: int64(l.TotalCount),
+		TotalPages: int64(l.TotalPages),
+		Page:       int64(l.Page),
+		Size:       int64(l.Size),
+		HasMore:    l.HasMore,
+		Users:      users,
+	}
+}
+
+// ChangeRole admin role change, roles are database role type values
+type ChangeRole struct {
+	Role Role `json:"role" validate:"required,oneof=admin user owner"`
+}
+
+// SuspendUser
+type SuspendUser struct {
+	Reason string `json:"reason" validate:"required,min=3,max=250"`
+}
@@ -21,12 +21,3 @@ type UpdateAvatarMsg struct {
 	ContentType string    `json:"content_type"`
 	Body        []byte
 }
-
-// Image model
-type Image struct {
-	ImageID    uuid.UUID `json:"image_id"`
-	ImageURL   string    `json:"image_url"`
-	IsUploaded bool      `json:"is_uploaded"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
-}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// LoginAttempts failed login counters of email and ip, LockedFor is remaining email lockout,
+// ThrottledFor is remaining delay before next login attempt of email
+type LoginAttempts struct {
+	EmailFailures int64
+	IPFailures    int64
+	LockedFor     time.Duration
+	ThrottledFor  time.Duration
+}
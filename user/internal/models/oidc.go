@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// OIDCState authorization request data kept until provider callback
+type OIDCState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OIDCCallback provider authorization response
+type OIDCCallback struct {
+	Code  string `query:"code" validate:"required,max=2048"`
+	State string `query:"state" validate:"required,max=250"`
+}
+
+// UserIdentity external provider account linked to user
+type UserIdentity struct {
+	IdentityID uuid.UUID  `json:"identity_id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Provider   string     `json:"provider"`
+	Subject    string     `json:"subject"`
+	Email      string     `json:"email"`
+	CreatedAt  *time.Time `json:"created_at"`
+}
@@ -1,6 +1,10 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
 	uuid "github.com/satori/go.uuid"
 
 	sessionService "github.com/AleksK1NG/hotels-mocroservices/user/proto/session"
@@ -8,8 +12,16 @@ import (
 
 // Session
 type Session struct {
-	UserID    uuid.UUID `json:"user_id"`
-	SessionID string    `json:"session_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	SessionID  string    `json:"-"`
+	DeviceID   string    `json:"device_id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+	Renewed    bool      `json:"-"`
 }
 
 // FromProto
@@ -20,5 +32,22 @@ func (s *Session) FromProto(session *sessionService.Session) (*Session, error) {
 	}
 	s.UserID = userUUID
 	s.SessionID = session.GetSessionID()
+	s.DeviceID = DeviceID(s.SessionID)
+	s.UserAgent = session.GetUserAgent()
+	s.IP = session.GetIP()
+	s.CreatedAt = session.GetCreatedAt().AsTime()
+	s.LastSeenAt = session.GetLastSeenAt().AsTime()
+	s.ExpiresAt = session.GetExpiresAt().AsTime()
 	return s, nil
 }
+
+// DeviceID opaque handle of session, session id is the cookie credential and must not be returned to clients
+func DeviceID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:])
+}
+
+// RevokedSessions
+type RevokedSessions struct {
+	Revoked int64 `json:"revoked"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	GrantTypePassword     = "password"
+	GrantTypeRefreshToken = "refresh_token"
+	GrantTypeTwoFactor    = "two_factor"
+
+	TokenTypeBearer = "Bearer"
+)
+
+// TokenRequest
+type TokenRequest struct {
+	GrantType    string `json:"grant_type" validate:"required,oneof=password refresh_token two_factor"`
+	Email        string `json:"email" validate:"required_if=GrantType password,max=250"`
+	Password     string `json:"password" validate:"required_if=GrantType password,max=250"`
+	RefreshToken string `json:"refresh_token" validate:"required_if=GrantType refresh_token,max=250"`
+	PendingToken string `json:"pending_token" validate:"required_if=GrantType two_factor,max=250"`
+	Code         string `json:"code" validate:"required_if=GrantType two_factor,max=64"`
+}
+
+// RevokeToken
+type RevokeToken struct {
+	RefreshToken string `json:"refresh_token" validate:"required,max=250"`
+}
+
+// TokenResponse
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// AccessTokenClaims subject is user id, FamilyID is refresh token family the token was issued for
+type AccessTokenClaims struct {
+	Role     string `json:"role"`
+	FamilyID string `json:"fid"`
+	jwt.StandardClaims
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// TwoFactor TOTP state of user, secret is encrypted
+type TwoFactor struct {
+	Secret    *string
+	EnabledAt *time.Time
+	LastStep  *int64
+}
+
+// TwoFactorEnroll
+type TwoFactorEnroll struct {
+	CurrentPassword string `json:"current_password" validate:"required,max=250"`
+}
+
+// TwoFactorEnrollment shared secret and otpauth uri to render as QR code
+type TwoFactorEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// TwoFactorCode TOTP code or recovery code
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required,max=64"`
+}
+
+// TwoFactorDisable
+type TwoFactorDisable struct {
+	CurrentPassword string `json:"current_password" validate:"required,max=250"`
+	Code            string `json:"code" validate:"required,max=64"`
+}
+
+// RecoveryCodes plain recovery codes, shown only once
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes"`
+}
+
+// TwoFactorLogin second login step
+type TwoFactorLogin struct {
+	PendingToken string `json:"pending_token" validate:"required,max=250"`
+	Code         string `json:"code" validate:"required,max=64"`
+}
+
+// TwoFactorChallenge returned by first login step when second factor is required
+type TwoFactorChallenge struct {
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	PendingToken      string    `json:"pending_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
@@ -9,6 +9,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/rbac"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/types"
 	userService "github.com/AleksK1NG/hotels-mocroservices/user/proto/user"
 )
@@ -24,6 +25,10 @@ type User struct {
 	Role      *Role                `json:"role"`
 	CreatedAt *time.Time           `json:"created_at"`
 	UpdatedAt *time.Time           `json:"updated_at"`
+
+	EmailVerifiedAt    *time.Time `json:"email_verified_at"`
+	TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at"`
+	SuspendedAt        *time.Time `json:"suspended_at"`
 }
 
 // User
@@ -36,6 +41,10 @@ type UserResponse struct {
 	Avatar    types.NullJSONString `json:"avatar" validate:"max=250" swaggertype:"string"`
 	CreatedAt *time.Time           `json:"created_at"`
 	UpdatedAt *time.Time           `json:"updated_at"`
+
+	EmailVerifiedAt    *time.Time `json:"email_verified_at"`
+	TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at"`
+	SuspendedAt        *time.Time `json:"suspended_at"`
 }
 
 // User
@@ -43,9 +52,7 @@ type UserUpdate struct {
 	UserID    uuid.UUID `json:"user_id"`
 	FirstName string    `json:"first_name" validate:"omitempty,min=3,max=25" swaggertype:"string"`
 	LastName  string    `json:"last_name" validate:"omitempty,min=3,max=25" swaggertype:"string"`
-	Email     string    `json:"email" validate:"omitempty,email" swaggertype:"string"`
 	Avatar    string    `json:"avatar" validate:"max=250" swaggertype:"string"`
-	Role      *Role     `json:"role"`
 }
 
 // Login
@@ -54,12 +61,42 @@ type Login struct {
 	Password string `json:"password" validate:"required,min=6,max=250"`
 }
 
+// ChangePassword
+type ChangePassword struct {
+	CurrentPassword string `json:"current_password" validate:"required,max=250"`
+	NewPassword     string `json:"new_password" validate:"required,min=6,max=250"`
+}
+
+// ChangeEmail
+type ChangeEmail struct {
+	CurrentPassword string `json:"current_password" validate:"required,max=250"`
+	Email           string `json:"email" validate:"required,email"`
+}
+
+// ConfirmEmail
+type ConfirmEmail struct {
+	Token string `json:"token" validate:"required,max=250"`
+}
+
+// ForgotPassword
+type ForgotPassword struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPassword
+type ResetPassword struct {
+	Token    string `json:"token" validate:"required,max=250"`
+	Password string `json:"password" validate:"required,min=6,max=250"`
+}
+
 type Role string
 
 const (
-	RoleGuest  Role = "guest"
-	RoleMember Role = "member"
-	RoleAdmin  Role = "admin"
+	RoleGuest  Role = rbac.RoleGuest
+	RoleMember Role = rbac.RoleMember
+	RoleUser   Role = rbac.RoleUser
+	RoleOwner  Role = rbac.RoleOwner
+	RoleAdmin  Role = rbac.RoleAdmin
 )
 
 func (e *Role) ToString() string {
@@ -104,16 +141,17 @@ func (u *User) SanitizePassword() {
 // Prepare user for register
 func (u *User) PrepareCreate() error {
 	u.Email = strings.ToLower(strings.TrimSpace(u.Email))
-	u.Password = strings.TrimSpace(u.Password)
+	return u.PreparePassword()
+}
 
-	if err := u.HashPassword(); err != nil {
-		return err
-	}
-	return nil
+// Trim and hash user password
+func (u *User) PreparePassword() error {
+	u.Password = strings.TrimSpace(u.Password)
+	return u.HashPassword()
 }
 
 func (r *UserResponse) ToProto() *userService.User {
-	return &userService.User{
+	res := &userService.User{
 		UserID:    r.UserID.String(),
 		FirstName: r.FirstName,
 		LastName:  r.LastName,
@@ -122,5 +160,91 @@ func (r *UserResponse) ToProto() *userService.User {
 		Role:      r.Role.ToString(),
 		CreatedAt: timestamppb.New(*r.CreatedAt),
 		UpdatedAt: timestamppb.New(*r.UpdatedAt),
+
+		Permissions: rbac.RolePermissions(r.Role.ToString()),
+	}
+	if r.EmailVerifiedAt != nil {
+		res.EmailVerifiedAt = timestamppb.New(*r.EmailVerifiedAt)
+	}
+	if r.TwoFactorEnabledAt != nil {
+		res.TwoFactorEnabledAt = timestamppb.New(*r.TwoFactorEnabledAt)
+	}
+	if r.SuspendedAt != nil {
+		res.SuspendedAt = timestamppb.New(*r.SuspendedAt)
+	}
+	return res
+}
+
+// IsEmailVerified
+func (r *UserResponse) IsEmailVerified() bool {
+	return r.EmailVerifiedAt != nil
+}
+
+// IsTwoFactorEnabled
+func (r *UserResponse) IsTwoFactorEnabled() bool {
+	return r.TwoFactorEnabledAt != nil
+}
+
+// IsSuspended
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// IsSuspended
+func (r *UserResponse) IsSuspended() bool {
+	return r.SuspendedAt != nil
+}
+
+// IsAdmin
+func (r *UserResponse) IsAdmin() bool {
+	return r.Role != nil && *r.Role == RoleAdmin
+}
+
+// HasPermission check user role grants all given permissions
+func (r *UserResponse) HasPermission(permissions ...rbac.Permission) bool {
+	return r.Role != nil && rbac.HasPermission(r.Role.ToString(), permissions...)
+}
+
+// ToPublicProfile projection without email and role, last name is shortened to initial
+func (r *UserResponse) ToPublicProfile() *userService.PublicProfile {
+	profile := &userService.PublicProfile{
+		UserID:      r.UserID.String(),
+		DisplayName: r.GetDisplayName(),
+		Avatar:      r.Avatar.String,
+	}
+	if r.CreatedAt != nil {
+		profile.MemberSince = timestamppb.New(*r.CreatedAt)
+	}
+	return profile
+}
+
+// GetDisplayName first name and last name initial
+func (r *UserResponse) GetDisplayName() string {
+	lastName := []rune(strings.TrimSpace(r.LastName))
+	if len(lastName) == 0 {
+		return r.FirstName
+	}
+	return fmt.Sprintf("%s %s.", r.FirstName, string(lastName[0]))
+}
+
+// UsersByIDs users in request order, not found or invalid ids are reported as missing
+type UsersByIDs struct {
+	Users      []*UserResponse
+	MissingIDs []string
+}
+
+func (u *UsersByIDs) ToProto() *userService.GetByIDsRes {
+	users := make([]*userService.User, 0, len(u.Users))
+	for _, user := range u.Users {
+		users = append(users, user.ToProto())
+	}
+	return &userService.GetByIDsRes{Users: users, MissingIDs: u.MissingIDs}
+}
+
+func (u *UsersByIDs) ToPublicProfilesProto() *userService.GetPublicProfilesRes {
+	profiles := make([]*userService.PublicProfile, 0, len(u.Users))
+	for _, user := range u.Users {
+		profiles = append(profiles, user.ToPublicProfile())
 	}
+	return &userService.GetPublicProfilesRes{Profiles: profiles, MissingIDs: u.MissingIDs}
 }
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"net"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
 	echoSwagger "github.com/swaggo/echo-swagger"
 )
 
@@ -42,3 +44,21 @@ func (s *Server) MapRoutes() {
 	// 	s.echo.Use(mw.DebugMiddleware)
 	// }
 }
+
+// ipExtractor client ip used by login throttling, X-Forwarded-For is read only when request comes from trusted proxy
+func (s *Server) ipExtractor() (echo.IPExtractor, error) {
+	if len(s.cfg.HttpServer.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range s.cfg.HttpServer.TrustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "net.ParseCIDR: %s", proxy)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
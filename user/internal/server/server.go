@@ -33,6 +33,9 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user/repository"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user/usecase"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/mailer"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/oidc"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/totp"
 	sessionService "github.com/AleksK1NG/hotels-mocroservices/user/proto/session"
 	userGRPCService "github.com/AleksK1NG/hotels-mocroservices/user/proto/user"
 )
@@ -86,10 +89,65 @@ func (s *Server) Run() error {
 
 	userPGRepository := repository.NewUserPGRepository(s.pgxPool)
 	userRedisRepository := repository.NewUserRedisRepository(s.redisConn, userCachePrefix, userCacheDuration)
-	userUseCase := usecase.NewUserUseCase(userPGRepository, sessServiceClient, userRedisRepository, s.logger, userPublisher)
+	resetTokenRepository := repository.NewTokenRedisRepository(
+		s.redisConn,
+		s.cfg.PasswordReset.TokenPrefix,
+		s.cfg.PasswordReset.TokenTTL*time.Second,
+	)
+	verifyTokenRepository := repository.NewTokenRedisRepository(
+		s.redisConn,
+		s.cfg.EmailVerification.TokenPrefix,
+		s.cfg.EmailVerification.TokenTTL*time.Second,
+	)
+
+	oidcStateRepository := repository.NewOIDCStateRedisRepository(
+		s.redisConn,
+		s.cfg.OIDC.StatePrefix,
+		s.cfg.OIDC.StateTTL*time.Second,
+	)
+
+	loginAttemptsRepository := repository.NewLoginAttemptsRedisRepository(
+		s.redisConn,
+		s.cfg.LoginProtection.Prefix,
+		s.cfg.LoginProtection.Window*time.Second,
+		s.cfg.LoginProtection.LockoutDuration*time.Second,
+	)
+
+	userMailer, err := mailer.NewMailer(s.cfg, s.logger)
+	if err != nil {
+		return errors.Wrap(err, "mailer.NewMailer")
+	}
+
+	oidcProviders, err := oidc.NewProviders(s.cfg)
+	if err != nil {
+		return errors.Wrap(err, "oidc.NewProviders")
+	}
+
+	totpCipher, err := totp.NewCipher(s.cfg.TwoFactor.EncryptionKey)
+	if err != nil {
+		return errors.Wrap(err, "totp.NewCipher")
+	}
+
+	userUseCase := usecase.NewUserUseCase(
+		s.cfg,
+		userPGRepository,
+		sessServiceClient,
+		userRedisRepository,
+		resetTokenRepository,
+		verifyTokenRepository,
+		oidcStateRepository,
+		loginAttemptsRepository,
+		oidcProviders,
+		totpCipher,
+		s.logger,
+		userPublisher,
+		userMailer,
+	)
 
 	middlewareManager := middlewares.NewMiddlewareManager(s.logger, s.cfg, userUseCase)
 
+	usersGroup.Use(middlewareManager.CSRFMiddleware)
+
 	uh := userHandlers.NewUserHandlers(usersGroup, userUseCase, s.logger, validate, s.cfg, middlewareManager)
 	uh.MapUserRoutes()
 
@@ -104,6 +162,7 @@ func (s *Server) Run() error {
 	defer avatarChan.Close()
 
 	userConsumer.RunConsumers(ctx, cancel)
+	defer userConsumer.CloseChannels()
 
 	s.echo.GET("/health", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Ok")
@@ -111,6 +170,12 @@ func (s *Server) Run() error {
 	s.echo.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
 	s.MapRoutes()
 
+	ipExtractor, err := s.ipExtractor()
+	if err != nil {
+		return errors.Wrap(err, "ipExtractor")
+	}
+	s.echo.IPExtractor = ipExtractor
+
 	go func() {
 		s.logger.Infof("Server is listening on PORT: %s", s.cfg.HttpServer.Port)
 		s.echo.Server.ReadTimeout = time.Second * s.cfg.HttpServer.ReadTimeout
@@ -145,10 +210,11 @@ func (s *Server) Run() error {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpcrecovery.UnaryServerInterceptor(),
 			im.Logger,
+			im.Permissions(userGRPC.MethodPermissions, userGRPC.NewRoleLoader(userUseCase)),
 		),
 	)
 
-	userService := userGRPC.NewUserService(userUseCase, s.logger)
+	userService := userGRPC.NewUserService(userUseCase, s.logger, validate)
 	userGRPCService.RegisterUserServiceServer(server, userService)
 	grpc_prometheus.Register(server)
 
@@ -178,6 +244,7 @@ func (s *Server) Run() error {
 	}
 
 	server.GracefulStop()
+	userConsumer.Drain(s.cfg.RabbitMQ.DrainTimeout * time.Second)
 	s.logger.Info("Server Exited Properly")
 
 	return nil
@@ -3,23 +3,40 @@ package grpc
 import (
 	"context"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/opentracing/opentracing-go"
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/interceptors"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/middlewares"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/grpc_errors"
+	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/rbac"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/utils"
 	"github.com/AleksK1NG/hotels-mocroservices/user/proto/user"
 )
 
+// MethodPermissions permissions required by user service methods, checked by interceptor with role of
+// request metadata user loaded by RoleLoader, usecase checks them again with role of acting user
+var MethodPermissions = map[string][]rbac.Permission{
+	"/userService.UserService/ListUsers":      {rbac.UserManage},
+	"/userService.UserService/ChangeUserRole": {rbac.UserManage},
+	"/userService.UserService/SuspendUser":    {rbac.UserManage},
+	"/userService.UserService/UnsuspendUser":  {rbac.UserManage},
+	"/userService.UserService/DeleteUser":     {rbac.UserManage},
+}
+
 type UserService struct {
-	userUC user.UseCase
-	logger logger.Logger
+	userUC   user.UseCase
+	logger   logger.Logger
+	validate *validator.Validate
 }
 
-func NewUserService(userUC user.UseCase, logger logger.Logger) *UserService {
-	return &UserService{userUC: userUC, logger: logger}
+func NewUserService(userUC user.UseCase, logger logger.Logger, validate *validator.Validate) *UserService {
+	return &UserService{userUC: userUC, logger: logger, validate: validate}
 }
 
 func (u *UserService) GetUserByID(ctx context.Context, r *userService.GetByIDRequest) (*userService.GetByIDResponse, error) {
@@ -42,7 +59,21 @@ func (u *UserService) GetUserByID(ctx context.Context, r *userService.GetByIDReq
 }
 
 func (u *UserService) GetUsersByIDs(ctx context.Context, req *userService.GetByIDsReq) (*userService.GetByIDsRes, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.GetUserByID")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.GetUsersByIDs")
+	defer span.Finish()
+
+	usersByIDs, err := u.userUC.GetUsersByIDs(ctx, req.GetUsersIDs())
+	if err != nil {
+		u.logger.Errorf("userUC.GetUsersByIDs: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "userUC.GetUsersByIDs")
+	}
+
+	return usersByIDs.ToProto(), nil
+}
+
+// GetPublicProfiles users data safe to show to other users, without email and role
+func (u *UserService) GetPublicProfiles(ctx context.Context, req *userService.GetPublicProfilesReq) (*userService.GetPublicProfilesRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.GetPublicProfiles")
 	defer span.Finish()
 
 	usersByIDs, err := u.userUC.GetUsersByIDs(ctx, req.GetUsersIDs())
@@ -51,16 +82,199 @@ func (u *UserService) GetUsersByIDs(ctx context.Context, req *userService.GetByI
 		return nil, grpc_errors.ErrorResponse(err, "userUC.GetUsersByIDs")
 	}
 
-	u.logger.Infof("USERS LIST RESPONSE: %v", u.idsToUUID(usersByIDs))
+	return usersByIDs.ToPublicProfilesProto(), nil
+}
+
+func (u *UserService) StreamUsersByIDs(req *userService.GetByIDsReq, stream userService.UserService_StreamUsersByIDsServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "UserService.StreamUsersByIDs")
+	defer span.Finish()
+
+	if err := u.userUC.StreamUsersByIDs(ctx, req.GetUsersIDs(), func(users *models.UsersByIDs) error {
+		return stream.Send(users.ToProto())
+	}); err != nil {
+		u.logger.Errorf("userUC.StreamUsersByIDs: %v", err)
+		return grpc_errors.ErrorResponse(err, "userUC.StreamUsersByIDs")
+	}
+
+	return nil
+}
+
+// ListUsers admin users list
+func (u *UserService) ListUsers(ctx context.Context, req *userService.ListUsersReq) (*userService.ListUsersRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.ListUsers")
+	defer span.Finish()
+
+	ctx, err := u.withActingUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &models.UsersQuery{
+		Page:   int(req.GetPage()),
+		Size:   int(req.GetSize()),
+		Search: req.GetSearch(),
+		Role:   req.GetRole(),
+		Status: req.GetStatus(),
+	}
+	if err := u.validate.StructCtx(ctx, query); err != nil {
+		u.logger.Errorf("validate.StructCtx: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "validate.StructCtx")
+	}
+
+	usersList, err := u.userUC.ListUsers(ctx, query)
+	if err != nil {
+		u.logger.Errorf("userUC.ListUsers: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "userUC.ListUsers")
+	}
+
+	return usersList.ToProto(), nil
+}
+
+// ChangeUserRole admin role change
+func (u *UserService) ChangeUserRole(ctx context.Context, req *userService.ChangeUserRoleReq) (*userService.AdminUserRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.ChangeUserRole")
+	defer span.Finish()
+
+	ctx, err := u.withActingUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.FromString(req.GetUserID())
+	if err != nil {
+		u.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "uuid.FromString")
+	}
 
-	return &userService.GetByIDsRes{Users: u.idsToUUID(usersByIDs)}, nil
+	change := &models.ChangeRole{Role: models.Role(req.GetRole())}
+	if err := u.validate.StructCtx(ctx, change); err != nil {
+		u.logger.Errorf("validate.StructCtx: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "validate.StructCtx")
+	}
+
+	adminUser, err := u.userUC.ChangeRole(ctx, userUUID, change.Role)
+	if err != nil {
+		u.logger.Errorf("userUC.ChangeRole: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "userUC.ChangeRole")
+	}
+
+	return &userService.AdminUserRes{User: adminUser.ToProto()}, nil
 }
 
-func (u *UserService) idsToUUID(users []*models.UserResponse) []*userService.User {
-	usersList := make([]*userService.User, 0, len(users))
-	for _, val := range users {
-		usersList = append(usersList, val.ToProto())
+// SuspendUser admin user suspension
+func (u *UserService) SuspendUser(ctx context.Context, req *userService.SuspendUserReq) (*userService.AdminUserRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.SuspendUser")
+	defer span.Finish()
+
+	ctx, err := u.withActingUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.FromString(req.GetUserID())
+	if err != nil {
+		u.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "uuid.FromString")
+	}
+
+	suspend := &models.SuspendUser{Reason: req.GetReason()}
+	if err := u.validate.StructCtx(ctx, suspend); err != nil {
+		u.logger.Errorf("validate.StructCtx: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "validate.StructCtx")
+	}
+
+	adminUser, err := u.userUC.SuspendUser(ctx, userUUID, suspend.Reason)
+	if err != nil {
+		u.logger.Errorf("userUC.SuspendUser: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "userUC.SuspendUser")
+	}
+
+	return &userService.AdminUserRes{User: adminUser.ToProto()}, nil
+}
+
+// UnsuspendUser admin user suspension lift
+func (u *UserService) UnsuspendUser(ctx context.Context, req *userService.UnsuspendUserReq) (*userService.AdminUserRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.UnsuspendUser")
+	defer span.Finish()
+
+	ctx, err := u.withActingUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.FromString(req.GetUserID())
+	if err != nil {
+		u.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "uuid.FromString")
+	}
+
+	adminUser, err := u.userUC.UnsuspendUser(ctx, userUUID)
+	if err != nil {
+		u.logger.Errorf("userUC.UnsuspendUser: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "userUC.UnsuspendUser")
+	}
+
+	return &userService.AdminUserRes{User: adminUser.ToProto()}, nil
+}
+
+// DeleteUser admin user deletion
+func (u *UserService) DeleteUser(ctx context.Context, req *userService.DeleteUserReq) (*userService.DeleteUserRes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UserService.DeleteUser")
+	defer span.Finish()
+
+	ctx, err := u.withActingUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.FromString(req.GetUserID())
+	if err != nil {
+		u.logger.Errorf("uuid.FromString: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "uuid.FromString")
+	}
+
+	if err := u.userUC.DeleteUser(ctx, userUUID); err != nil {
+		u.logger.Errorf("userUC.DeleteUser: %v", err)
+		return nil, grpc_errors.ErrorResponse(err, "userUC.DeleteUser")
+	}
+
+	return &userService.DeleteUserRes{}, nil
+}
+
+// NewRoleLoader load role of request metadata user for permissions interceptor
+func NewRoleLoader(userUC user.UseCase) interceptors.RoleLoader {
+	return func(ctx context.Context, userID uuid.UUID) (string, error) {
+		foundUser, err := userUC.GetByID(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+		if foundUser.Role == nil {
+			return "", nil
+		}
+		return foundUser.Role.ToString(), nil
+	}
+}
+
+// withActingUser load user from request metadata into ctx the same way SessionMiddleware does for http requests,
+// suspended users and admins without two-factor authentication are rejected
+func (u *UserService) withActingUser(ctx context.Context) (context.Context, error) {
+	userID, err := utils.GetUserIDFromCtx(ctx)
+	if err != nil {
+		u.logger.Errorf("GetUserIDFromCtx: %v", err)
+		return nil, grpc_errors.ErrorResponse(grpc_errors.ErrNoCtxMetaData, "GetUserIDFromCtx")
+	}
+
+	actingUser, err := u.userUC.GetByID(ctx, userID)
+	if err != nil {
+		u.logger.Errorf("userUC.GetByID: %v", err)
+		return nil, grpc_errors.ErrorResponse(grpc_errors.ErrNoCtxMetaData, "userUC.GetByID")
+	}
+	if actingUser.IsSuspended() {
+		return nil, grpc_errors.ErrorResponse(grpc_errors.ErrPermissionDenied, "acting user suspended")
+	}
+	if actingUser.IsAdmin() && !actingUser.IsTwoFactorEnabled() {
+		return nil, grpc_errors.ErrorResponse(httpErrors.TwoFactorEnrollmentRequired, "acting admin without two-factor")
 	}
 
-	return usersList
+	return context.WithValue(ctx, middlewares.RequestCtxUser{}, actingUser), nil
 }
@@ -7,7 +7,6 @@ import (
 	"mime/multipart"
 	"net/http"
 	"sync"
-	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -18,12 +17,12 @@ import (
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/middlewares"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/events"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
 )
 
 const (
-	csrfHeader  = "X-CSRF-Token"
 	maxFileSize = 1024 * 1024 * 10
 )
 
@@ -78,19 +77,13 @@ func (h *userHandlers) Register() echo.HandlerFunc {
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		sessionID, err := h.userUC.CreateSession(ctx, regUser.UserID)
+		sess, err := h.userUC.CreateSession(ctx, regUser.UserID, c.Request().UserAgent(), c.RealIP())
 		if err != nil {
 			h.logger.Errorf("userHandlers.userUC.CreateSession: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		c.SetCookie(&http.Cookie{
-			Name:     h.cfg.HttpServer.SessionCookieName,
-			Value:    sessionID,
-			Path:     "/",
-			HttpOnly: true,
-			Expires:  time.Now().Add(time.Duration(h.cfg.HttpServer.CookieLifeTime) * time.Minute),
-		})
+		h.mw.SetSessionCookie(c, sess)
 
 		return c.JSON(http.StatusCreated, regUser)
 	}
@@ -121,89 +114,568 @@ func (h *userHandlers) Login() echo.HandlerFunc {
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		userResponse, err := h.userUC.Login(ctx, login)
+		userResponse, err := h.userUC.Login(ctx, login, c.RealIP())
 		if err != nil {
 			h.logger.Errorf("userHandlers.userUC.Login: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		sessionID, err := h.userUC.CreateSession(ctx, userResponse.UserID)
+		if userResponse.TwoFactorEnabledAt != nil {
+			challenge, err := h.userUC.CreatePendingLogin(ctx, userResponse.UserID, c.Request().UserAgent(), c.RealIP())
+			if err != nil {
+				h.logger.Errorf("userHandlers.Login.CreatePendingLogin: %v", err)
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+			return c.JSON(http.StatusAccepted, challenge)
+		}
+
+		sess, err := h.userUC.CreateSession(ctx, userResponse.UserID, c.Request().UserAgent(), c.RealIP())
+		if err != nil {
+			h.logger.Errorf("userHandlers.Login.CreateSession: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		h.mw.SetSessionCookie(c, sess)
+
+		return c.JSON(http.StatusOK, userResponse)
+	}
+}
+
+// Logout godoc
+// @Summary Logout user
+// @Tags User
+// @Description Logout user, return no content
+// @Accept json
+// @Produce json
+// @Success 204 ""
+// @Router /user/logout [post]
+func (h *userHandlers) Logout() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.Logout")
+		defer span.Finish()
+
+		cookie, err := c.Cookie(h.cfg.HttpServer.SessionCookieName)
+		if err != nil {
+			if errors.Is(err, http.ErrNoCookie) {
+				h.logger.Errorf("userHandlers.Logout.http.ErrNoCookie: %v", err)
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+			h.logger.Errorf("userHandlers.Logout.c.Cookie: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.DeleteSession(ctx, cookie.Value); err != nil {
+			h.logger.Errorf("userHandlers.userUC.DeleteSession: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		h.mw.ClearSessionCookie(c)
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// ResendVerification godoc
+// @Summary Resend email verification
+// @Tags User
+// @Description send new email verification link to current user, required session
+// @Accept json
+// @Produce json
+// @Success 202 ""
+// @Router /user/verify-email/resend [post]
+func (h *userHandlers) ResendVerification() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ResendVerification")
+		defer span.Finish()
+
+		if err := h.userUC.ResendVerification(ctx); err != nil {
+			h.logger.Errorf("userHandlers.userUC.ResendVerification: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+}
+
+// ConfirmEmail godoc
+// @Summary Confirm email
+// @Tags User
+// @Description confirm user email by verification token
+// @Accept json
+// @Produce json
+// @Param data body models.ConfirmEmail true "verification token"
+// @Success 200 {object} models.UserResponse
+// @Router /user/verify-email/confirm [post]
+func (h *userHandlers) ConfirmEmail() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ConfirmEmail")
+		defer span.Finish()
+
+		var confirm models.ConfirmEmail
+		if err := c.Bind(&confirm); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &confirm); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		userResponse, err := h.userUC.ConfirmEmail(ctx, confirm.Token)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.ConfirmEmail: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, userResponse)
+	}
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Tags User
+// @Description change password of current user, requires current password, revokes other user sessions
+// @Accept json
+// @Produce json
+// @Param data body models.ChangePassword true "current and new password"
+// @Success 204 ""
+// @Router /user/me/password [put]
+func (h *userHandlers) ChangePassword() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ChangePassword")
+		defer span.Finish()
+
+		var change models.ChangePassword
+		if err := c.Bind(&change); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &change); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.ChangePassword(ctx, &change); err != nil {
+			h.logger.Errorf("userHandlers.userUC.ChangePassword: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// ChangeEmail godoc
+// @Summary Change email
+// @Tags User
+// @Description change email of current user, requires current password, revokes other user sessions and sends verification to new email
+// @Accept json
+// @Produce json
+// @Param data body models.ChangeEmail true "current password and new email"
+// @Success 200 {object} models.UserResponse
+// @Router /user/me/email [put]
+func (h *userHandlers) ChangeEmail() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ChangeEmail")
+		defer span.Finish()
+
+		var change models.ChangeEmail
+		if err := c.Bind(&change); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &change); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		userResponse, err := h.userUC.ChangeEmail(ctx, &change)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.ChangeEmail: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, userResponse)
+	}
+}
+
+// ForgotPassword godoc
+// @Summary Request password reset
+// @Tags User
+// @Description send password reset link to user email, always returns accepted to not disclose registered emails
+// @Accept json
+// @Produce json
+// @Param data body models.ForgotPassword true "user email"
+// @Success 202 ""
+// @Router /user/forgot-password [post]
+func (h *userHandlers) ForgotPassword() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ForgotPassword")
+		defer span.Finish()
+
+		var forgot models.ForgotPassword
+		if err := c.Bind(&forgot); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &forgot); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.ForgotPassword(ctx, forgot.Email); err != nil {
+			h.logger.Errorf("userHandlers.userUC.ForgotPassword: %v", err)
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Tags User
+// @Description set new password by reset token, revokes every user session
+// @Accept json
+// @Produce json
+// @Param data body models.ResetPassword true "reset token and new password"
+// @Success 204 ""
+// @Router /user/reset-password [post]
+func (h *userHandlers) ResetPassword() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ResetPassword")
+		defer span.Finish()
+
+		var reset models.ResetPassword
+		if err := c.Bind(&reset); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &reset); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.ResetPassword(ctx, &reset); err != nil {
+			h.logger.Errorf("userHandlers.userUC.ResetPassword: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		h.mw.ClearSessionCookie(c)
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// GetMe godoc
+// @Summary Get current user data
+// @Tags User
+// @Description Get current user data, required session cookie
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.UserResponse
+// @Router /user/me [get]
+func (h *userHandlers) GetMe() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.GetMe")
+		defer span.Finish()
+
+		userResponse, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+		if !ok {
+			h.logger.Error("invalid middleware user ctx")
+			return httpErrors.ErrorCtxResponse(c, httpErrors.WrongCredentials)
+		}
+
+		return c.JSON(http.StatusOK, userResponse)
+	}
+}
+
+// GetSessions godoc
+// @Summary Get current user sessions
+// @Tags User
+// @Description Get active sessions (devices) of current user, required session
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Session
+// @Router /user/me/sessions [get]
+func (h *userHandlers) GetSessions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.GetSessions")
+		defer span.Finish()
+
+		sessions, err := h.userUC.ListSessions(ctx)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.ListSessions: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, sessions)
+	}
+}
+
+// RevokeSession godoc
+// @Summary Revoke session
+// @Tags User
+// @Description Revoke one of current user sessions (sign out device), required session
+// @Accept json
+// @Produce json
+// @Param device_id path string true "device id from sessions list"
+// @Success 204 ""
+// @Router /user/me/sessions/{device_id} [delete]
+func (h *userHandlers) RevokeSession() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.RevokeSession")
+		defer span.Finish()
+
+		deviceID := c.Param("device_id")
+		if deviceID == "" {
+			h.logger.Error("invalid device id param")
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadRequest)
+		}
+
+		if err := h.userUC.RevokeSession(ctx, deviceID); err != nil {
+			h.logger.Errorf("userHandlers.userUC.RevokeSession: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// UnlockLogin godoc
+// @Summary Unlock user login
+// @Tags User
+// @Description Reset failed login attempts and lockout of user email, admin only, required session
+// @Accept json
+// @Produce json
+// @Param id path string true "user uuid"
+// @Success 204 ""
+// @Router /user/{id}/unlock [post]
+func (h *userHandlers) UnlockLogin() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.UnlockLogin")
+		defer span.Finish()
+
+		userUUID, err := uuid.FromString(c.Param("id"))
+		if err != nil {
+			h.logger.Errorf("uuid.FromString: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.UnlockLogin(ctx, userUUID); err != nil {
+			h.logger.Errorf("userHandlers.userUC.UnlockLogin: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Tags Admin
+// @Description Paginated users list with search by email or name, role and account status filters, admin only, required session
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Param search query string false "email, first or last name"
+// @Param role query string false "admin, user or owner"
+// @Param status query string false "active, suspended or deleted"
+// @Success 200 {object} models.UsersList
+// @Router /user/admin/users [get]
+func (h *userHandlers) ListUsers() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ListUsers")
+		defer span.Finish()
+
+		var query models.UsersQuery
+		if err := c.Bind(&query); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, httpErrors.BadQueryParams)
+		}
+
+		if err := h.validate.StructCtx(ctx, &query); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		usersList, err := h.userUC.ListUsers(ctx, &query)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.ListUsers: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, usersList)
+	}
+}
+
+// ChangeRole godoc
+// @Summary Change user role
+// @Tags Admin
+// @Description Change role of user, admin only, required session
+// @Accept json
+// @Produce json
+// @Param id path string true "user uuid"
+// @Param data body models.ChangeRole true "new role"
+// @Success 200 {object} models.AdminUser
+// @Router /user/admin/users/{id}/role [put]
+func (h *userHandlers) ChangeRole() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ChangeRole")
+		defer span.Finish()
+
+		userUUID, err := uuid.FromString(c.Param("id"))
+		if err != nil {
+			h.logger.Errorf("uuid.FromString: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var change models.ChangeRole
+		if err := c.Bind(&change); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &change); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		adminUser, err := h.userUC.ChangeRole(ctx, userUUID, change.Role)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.ChangeRole: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, adminUser)
+	}
+}
+
+// SuspendUser godoc
+// @Summary Suspend user
+// @Tags Admin
+// @Description Suspend user account with reason and revoke all user sessions, admin only, required session
+// @Accept json
+// @Produce json
+// @Param id path string true "user uuid"
+// @Param data body models.SuspendUser true "suspension reason"
+// @Success 200 {object} models.AdminUser
+// @Router /user/admin/users/{id}/suspend [post]
+func (h *userHandlers) SuspendUser() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.SuspendUser")
+		defer span.Finish()
+
+		userUUID, err := uuid.FromString(c.Param("id"))
+		if err != nil {
+			h.logger.Errorf("uuid.FromString: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		var suspend models.SuspendUser
+		if err := c.Bind(&suspend); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &suspend); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		adminUser, err := h.userUC.SuspendUser(ctx, userUUID, suspend.Reason)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.SuspendUser: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, adminUser)
+	}
+}
+
+// UnsuspendUser godoc
+// @Summary Unsuspend user
+// @Tags Admin
+// @Description Lift user account suspension, admin only, required session
+// @Accept json
+// @Produce json
+// @Param id path string true "user uuid"
+// @Success 200 {object} models.AdminUser
+// @Router /user/admin/users/{id}/suspend [delete]
+func (h *userHandlers) UnsuspendUser() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.UnsuspendUser")
+		defer span.Finish()
+
+		userUUID, err := uuid.FromString(c.Param("id"))
+		if err != nil {
+			h.logger.Errorf("uuid.FromString: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		adminUser, err := h.userUC.UnsuspendUser(ctx, userUUID)
 		if err != nil {
-			h.logger.Errorf("userHandlers.Login.CreateSession: %v", err)
+			h.logger.Errorf("userHandlers.userUC.UnsuspendUser: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		c.SetCookie(&http.Cookie{
-			Name:     h.cfg.HttpServer.SessionCookieName,
-			Value:    sessionID,
-			Path:     "/",
-			HttpOnly: true,
-			Expires:  time.Now().Add(time.Duration(h.cfg.HttpServer.CookieLifeTime) * time.Minute),
-		})
-
-		return c.JSON(http.StatusOK, userResponse)
+		return c.JSON(http.StatusOK, adminUser)
 	}
 }
 
-// Logout godoc
-// @Summary Logout user
-// @Tags User
-// @Description Logout user, return no content
+// DeleteUser godoc
+// @Summary Delete user
+// @Tags Admin
+// @Description Erase user personal data and linked accounts and revoke all user sessions, admin only, required session
 // @Accept json
 // @Produce json
+// @Param id path string true "user uuid"
 // @Success 204 ""
-// @Router /user/logout [post]
-func (h *userHandlers) Logout() echo.HandlerFunc {
+// @Router /user/admin/users/{id} [delete]
+func (h *userHandlers) DeleteUser() echo.HandlerFunc {
 	return func(c echo.Context) error {
-		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.Logout")
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.DeleteUser")
 		defer span.Finish()
 
-		cookie, err := c.Cookie(h.cfg.HttpServer.SessionCookieName)
+		userUUID, err := uuid.FromString(c.Param("id"))
 		if err != nil {
-			if errors.Is(err, http.ErrNoCookie) {
-				h.logger.Errorf("userHandlers.Logout.http.ErrNoCookie: %v", err)
-				return httpErrors.ErrorCtxResponse(c, err)
-			}
-			h.logger.Errorf("userHandlers.Logout.c.Cookie: %v", err)
+			h.logger.Errorf("uuid.FromString: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		if err := h.userUC.DeleteSession(ctx, cookie.Value); err != nil {
-			h.logger.Errorf("userHandlers.userUC.DeleteSession: %v", err)
+		if err := h.userUC.DeleteUser(ctx, userUUID); err != nil {
+			h.logger.Errorf("userHandlers.userUC.DeleteUser: %v", err)
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		c.SetCookie(&http.Cookie{
-			Name:   h.cfg.HttpServer.SessionCookieName,
-			Value:  "",
-			Path:   "/",
-			MaxAge: -1,
-		})
-
 		return c.NoContent(http.StatusNoContent)
 	}
 }
 
-// GetMe godoc
-// @Summary Get current user data
+// RevokeOtherSessions godoc
+// @Summary Revoke other sessions
 // @Tags User
-// @Description Get current user data, required session cookie
+// @Description Revoke all current user sessions except the current one, required session
 // @Accept json
 // @Produce json
-// @Success 200 {object} models.UserResponse
-// @Router /user/me [get]
-func (h *userHandlers) GetMe() echo.HandlerFunc {
+// @Success 200 {object} models.RevokedSessions
+// @Router /user/me/sessions [delete]
+func (h *userHandlers) RevokeOtherSessions() echo.HandlerFunc {
 	return func(c echo.Context) error {
-		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.GetMe")
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.RevokeOtherSessions")
 		defer span.Finish()
 
-		userResponse, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
-		if !ok {
-			h.logger.Error("invalid middleware user ctx")
-			return httpErrors.ErrorCtxResponse(c, httpErrors.WrongCredentials)
+		revoked, err := h.userUC.RevokeOtherSessions(ctx)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.RevokeOtherSessions: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		return c.JSON(http.StatusOK, userResponse)
+		return c.JSON(http.StatusOK, &models.RevokedSessions{Revoked: revoked})
 	}
 }
 
@@ -232,7 +704,7 @@ func (h *userHandlers) GetCSRFToken() echo.HandlerFunc {
 			return httpErrors.ErrorCtxResponse(c, err)
 		}
 
-		c.Response().Header().Set(csrfHeader, csrfToken)
+		c.Response().Header().Set(h.cfg.HttpServer.CSRFHeader, csrfToken)
 
 		return c.NoContent(http.StatusOK)
 	}
@@ -387,6 +859,7 @@ func (h *userHandlers) UpdateAvatar() echo.HandlerFunc {
 			Body:        buf.Bytes(),
 		}
 
+		ctx = events.WithCorrelationID(ctx, c.Response().Header().Get(echo.HeaderXRequestID))
 		if err := h.userUC.UpdateAvatar(ctx, data); err != nil {
 			h.logger.Error("h.userUC.UpdateAvatar")
 			return httpErrors.ErrorCtxResponse(c, err)
@@ -425,3 +898,361 @@ func (h *userHandlers) checkAvatar(file multipart.File) (string, error) {
 
 	return ContentType, nil
 }
+
+// Token godoc
+// @Summary Issue access token
+// @Tags User
+// @Description exchange credentials or refresh token for short-lived JWT access token and rotated refresh token,
+// @Description users with two-factor enabled get challenge for password grant and finish it with two_factor grant
+// @Accept json
+// @Produce json
+// @Param data body models.TokenRequest true "grant type with credentials, refresh token or pending token and code"
+// @Success 200 {object} models.TokenResponse
+// @Success 202 {object} models.TwoFactorChallenge
+// @Router /user/token [post]
+func (h *userHandlers) Token() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.Token")
+		defer span.Finish()
+
+		var req models.TokenRequest
+		if err := c.Bind(&req); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &req); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		token, challenge, err := h.userUC.IssueToken(ctx, &req, c.Request().UserAgent(), c.RealIP())
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.IssueToken: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		if challenge != nil {
+			return c.JSON(http.StatusAccepted, challenge)
+		}
+		return c.JSON(http.StatusOK, token)
+	}
+}
+
+// RevokeToken godoc
+// @Summary Revoke refresh token
+// @Tags User
+// @Description revoke refresh token and every token rotated from the same login
+// @Accept json
+// @Produce json
+// @Param data body models.RevokeToken true "refresh token"
+// @Success 204 ""
+// @Router /user/token/revoke [post]
+func (h *userHandlers) RevokeToken() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.RevokeToken")
+		defer span.Finish()
+
+		var revoke models.RevokeToken
+		if err := c.Bind(&revoke); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &revoke); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.RevokeRefreshToken(ctx, revoke.RefreshToken); err != nil {
+			h.logger.Errorf("userHandlers.userUC.RevokeRefreshToken: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// OIDCLogin godoc
+// @Summary Login with OpenID Connect provider
+// @Tags User
+// @Description redirect to provider authorization endpoint, authorization code flow with PKCE
+// @Param provider path string true "provider name"
+// @Success 302 ""
+// @Router /user/oidc/{provider}/login [get]
+func (h *userHandlers) OIDCLogin() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.OIDCLogin")
+		defer span.Finish()
+
+		authURL, state, err := h.userUC.OIDCAuthURL(ctx, c.Param("provider"))
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.OIDCAuthURL: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		c.SetCookie(&http.Cookie{
+			Name:     h.cfg.OIDC.StateCookieName,
+			Value:    state,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   h.cfg.HttpServer.CookieSecure,
+			MaxAge:   int(h.cfg.OIDC.StateTTL),
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		return c.Redirect(http.StatusFound, authURL)
+	}
+}
+
+// OIDCCallback godoc
+// @Summary OpenID Connect provider callback
+// @Tags User
+// @Description exchange authorization code, link provider account to user and create session
+// @Produce json
+// @Param provider path string true "provider name"
+// @Param code query string true "authorization code"
+// @Param state query string true "state"
+// @Success 200 {object} models.UserResponse
+// @Router /user/oidc/{provider}/callback [get]
+func (h *userHandlers) OIDCCallback() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.OIDCCallback")
+		defer span.Finish()
+
+		var callback models.OIDCCallback
+		if err := c.Bind(&callback); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &callback); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		stateCookie, err := c.Cookie(h.cfg.OIDC.StateCookieName)
+		if err != nil || stateCookie.Value != callback.State {
+			h.logger.Errorf("userHandlers.OIDCCallback state cookie mismatch: %v", err)
+			return httpErrors.ErrorCtxResponse(c, httpErrors.InvalidOIDCState)
+		}
+
+		c.SetCookie(&http.Cookie{
+			Name:   h.cfg.OIDC.StateCookieName,
+			Value:  "",
+			Path:   "/",
+			MaxAge: -1,
+		})
+
+		userResponse, err := h.userUC.OIDCCallback(ctx, c.Param("provider"), &callback)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.OIDCCallback: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if userResponse.IsTwoFactorEnabled() {
+			challenge, err := h.userUC.CreatePendingLogin(ctx, userResponse.UserID, c.Request().UserAgent(), c.RealIP())
+			if err != nil {
+				h.logger.Errorf("userHandlers.OIDCCallback.CreatePendingLogin: %v", err)
+				return httpErrors.ErrorCtxResponse(c, err)
+			}
+			return c.JSON(http.StatusAccepted, challenge)
+		}
+
+		sess, err := h.userUC.CreateSession(ctx, userResponse.UserID, c.Request().UserAgent(), c.RealIP())
+		if err != nil {
+			h.logger.Errorf("userHandlers.OIDCCallback.CreateSession: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		h.mw.SetSessionCookie(c, sess)
+
+		return c.JSON(http.StatusOK, userResponse)
+	}
+}
+
+// LoginTwoFactor godoc
+// @Summary Login second step
+// @Tags User
+// @Description verify TOTP or recovery code of pending login and create session
+// @Accept json
+// @Produce json
+// @Param data body models.TwoFactorLogin true "pending token and code"
+// @Success 200 {object} models.UserResponse
+// @Router /user/login/2fa [post]
+func (h *userHandlers) LoginTwoFactor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.LoginTwoFactor")
+		defer span.Finish()
+
+		var login models.TwoFactorLogin
+		if err := c.Bind(&login); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &login); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		userResponse, err := h.userUC.VerifyTwoFactorLogin(ctx, &login, c.RealIP())
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.VerifyTwoFactorLogin: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		sess, err := h.userUC.CreateSession(ctx, userResponse.UserID, c.Request().UserAgent(), c.RealIP())
+		if err != nil {
+			h.logger.Errorf("userHandlers.LoginTwoFactor.CreateSession: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		h.mw.SetSessionCookie(c, sess)
+
+		return c.JSON(http.StatusOK, userResponse)
+	}
+}
+
+// EnrollTwoFactor godoc
+// @Summary Enroll two-factor authentication
+// @Tags User
+// @Description generate TOTP secret and provisioning uri, requires current password
+// @Accept json
+// @Produce json
+// @Param data body models.TwoFactorEnroll true "current password"
+// @Success 200 {object} models.TwoFactorEnrollment
+// @Router /user/me/2fa/enroll [post]
+func (h *userHandlers) EnrollTwoFactor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.EnrollTwoFactor")
+		defer span.Finish()
+
+		var enroll models.TwoFactorEnroll
+		if err := c.Bind(&enroll); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &enroll); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		enrollment, err := h.userUC.EnrollTwoFactor(ctx, &enroll)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.EnrollTwoFactor: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return c.JSON(http.StatusOK, enrollment)
+	}
+}
+
+// ConfirmTwoFactor godoc
+// @Summary Confirm two-factor authentication
+// @Tags User
+// @Description enable two-factor with first TOTP code, returns recovery codes shown only once
+// @Accept json
+// @Produce json
+// @Param data body models.TwoFactorCode true "TOTP code"
+// @Success 200 {object} models.RecoveryCodes
+// @Router /user/me/2fa/confirm [post]
+func (h *userHandlers) ConfirmTwoFactor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.ConfirmTwoFactor")
+		defer span.Finish()
+
+		var code models.TwoFactorCode
+		if err := c.Bind(&code); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &code); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		recoveryCodes, err := h.userUC.ConfirmTwoFactor(ctx, code.Code)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.ConfirmTwoFactor: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return c.JSON(http.StatusOK, recoveryCodes)
+	}
+}
+
+// DisableTwoFactor godoc
+// @Summary Disable two-factor authentication
+// @Tags User
+// @Description requires current password and TOTP or recovery code, not allowed for admins
+// @Accept json
+// @Produce json
+// @Param data body models.TwoFactorDisable true "current password and code"
+// @Success 204 ""
+// @Router /user/me/2fa [delete]
+func (h *userHandlers) DisableTwoFactor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.DisableTwoFactor")
+		defer span.Finish()
+
+		var disable models.TwoFactorDisable
+		if err := c.Bind(&disable); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &disable); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.userUC.DisableTwoFactor(ctx, &disable); err != nil {
+			h.logger.Errorf("userHandlers.userUC.DisableTwoFactor: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Tags User
+// @Description replace recovery codes, requires current TOTP code
+// @Accept json
+// @Produce json
+// @Param data body models.TwoFactorCode true "TOTP code"
+// @Success 200 {object} models.RecoveryCodes
+// @Router /user/me/2fa/recovery-codes [post]
+func (h *userHandlers) RegenerateRecoveryCodes() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "user.RegenerateRecoveryCodes")
+		defer span.Finish()
+
+		var code models.TwoFactorCode
+		if err := c.Bind(&code); err != nil {
+			h.logger.Errorf("c.Bind: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		if err := h.validate.StructCtx(ctx, &code); err != nil {
+			h.logger.Errorf("validate.StructCtx: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		recoveryCodes, err := h.userUC.RegenerateRecoveryCodes(ctx, code.Code)
+		if err != nil {
+			h.logger.Errorf("userHandlers.userUC.RegenerateRecoveryCodes: %v", err)
+			return httpErrors.ErrorCtxResponse(c, err)
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return c.JSON(http.StatusOK, recoveryCodes)
+	}
+}
@@ -1,12 +1,38 @@
 package http
 
+import "github.com/AleksK1NG/hotels-mocroservices/user/pkg/rbac"
+
 // MapUserRoutes
 func (h *userHandlers) MapUserRoutes() {
 	h.group.POST("/register", h.Register())
 	h.group.POST("/login", h.Login())
+	h.group.POST("/login/2fa", h.LoginTwoFactor())
+	h.group.POST("/verify-email/resend", h.ResendVerification(), h.mw.SessionMiddleware)
+	h.group.POST("/verify-email/confirm", h.ConfirmEmail())
+	h.group.POST("/forgot-password", h.ForgotPassword())
+	h.group.POST("/reset-password", h.ResetPassword())
+	h.group.POST("/token", h.Token())
+	h.group.POST("/token/revoke", h.RevokeToken())
+	h.group.GET("/oidc/:provider/login", h.OIDCLogin())
+	h.group.GET("/oidc/:provider/callback", h.OIDCCallback())
 	h.group.PUT("/:id/avatar", h.UpdateAvatar(), h.mw.SessionMiddleware)
 	h.group.GET("/:id", h.GetUserByID())
 	h.group.PUT("/:id", h.Update(), h.mw.SessionMiddleware)
+	h.group.POST("/:id/unlock", h.UnlockLogin(), h.mw.SessionMiddleware, h.mw.RequirePermission(rbac.UserManage))
+	h.group.GET("/admin/users", h.ListUsers(), h.mw.SessionMiddleware, h.mw.RequirePermission(rbac.UserManage))
+	h.group.PUT("/admin/users/:id/role", h.ChangeRole(), h.mw.SessionMiddleware, h.mw.RequirePermission(rbac.UserManage))
+	h.group.POST("/admin/users/:id/suspend", h.SuspendUser(), h.mw.SessionMiddleware, h.mw.RequirePermission(rbac.UserManage))
+	h.group.DELETE("/admin/users/:id/suspend", h.UnsuspendUser(), h.mw.SessionMiddleware, h.mw.RequirePermission(rbac.UserManage))
+	h.group.DELETE("/admin/users/:id", h.DeleteUser(), h.mw.SessionMiddleware, h.mw.RequirePermission(rbac.UserManage))
 	h.group.GET("/me", h.GetMe(), h.mw.SessionMiddleware)
+	h.group.PUT("/me/password", h.ChangePassword(), h.mw.SessionMiddleware)
+	h.group.PUT("/me/email", h.ChangeEmail(), h.mw.SessionMiddleware)
+	h.group.POST("/me/2fa/enroll", h.EnrollTwoFactor(), h.mw.SessionMiddleware)
+	h.group.POST("/me/2fa/confirm", h.ConfirmTwoFactor(), h.mw.SessionMiddleware)
+	h.group.POST("/me/2fa/recovery-codes", h.RegenerateRecoveryCodes(), h.mw.SessionMiddleware)
+	h.group.DELETE("/me/2fa", h.DisableTwoFactor(), h.mw.SessionMiddleware)
+	h.group.GET("/me/sessions", h.GetSessions(), h.mw.SessionMiddleware)
+	h.group.DELETE("/me/sessions", h.RevokeOtherSessions(), h.mw.SessionMiddleware)
+	h.group.DELETE("/me/sessions/:device_id", h.RevokeSession(), h.mw.SessionMiddleware)
 	h.group.GET("/csrf", h.GetCSRFToken(), h.mw.SessionMiddleware)
 }
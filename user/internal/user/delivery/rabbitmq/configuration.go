@@ -20,7 +20,6 @@ const (
 	publishMandatory = false
 	publishImmediate = false
 
-	prefetchCount  = 1
 	prefetchSize   = 0
 	prefetchGlobal = false
 
@@ -31,10 +30,14 @@ const (
 
 	UserExchange = "users"
 
+	deadLetterExchange    = "users_dead_letter"
+	deadLetterQueueSuffix = "_dlq"
+
 	AvatarsQueueName   = "avatars_queue"
 	AvatarsConsumerTag = "user_avatar_consumer"
-	AvatarsWorkers     = 5
 	AvatarsBindingKey  = "update_avatar_key"
+
+	UserUpdatedKey = "user_updated_key"
 )
 
 var (
@@ -50,4 +53,8 @@ var (
 		Name: "rabbitmq_images_error_messages_total",
 		Help: "The total number of error incoming success RabbitMQ messages",
 	})
+	busyWorkers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rabbitmq_users_busy_workers",
+		Help: "The number of workers processing RabbitMQ delivery per queue",
+	}, []string{"queue"})
 )
@@ -3,6 +3,7 @@ package rabbitmq
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/streadway/amqp"
@@ -18,10 +19,15 @@ type UserConsumer struct {
 	logger   logger.Logger
 	cfg      *config.Config
 	userUC   user.UseCase
+
+	mu              sync.Mutex
+	channels        []*amqp.Channel
+	consumeChannels map[string]*amqp.Channel
+	workers         sync.WaitGroup
 }
 
 func NewUserConsumer(logger logger.Logger, cfg *config.Config, userUC user.UseCase) *UserConsumer {
-	return &UserConsumer{logger: logger, cfg: cfg, userUC: userUC}
+	return &UserConsumer{logger: logger, cfg: cfg, userUC: userUC, consumeChannels: make(map[string]*amqp.Channel)}
 }
 
 func (c *UserConsumer) Dial() error {
@@ -54,6 +60,12 @@ func (c *UserConsumer) CreateExchangeAndQueue(exchangeName, queueName, bindingKe
 		return nil, errors.Wrap(err, "Error ch.ExchangeDeclare")
 	}
 
+	if err := c.declareDeadLetterQueue(ch, queueName); err != nil {
+		return nil, errors.Wrap(err, "declareDeadLetterQueue")
+	}
+
+	// rejected deliveries (invalid payload, unknown event version) are routed to queueName_dlq by broker policy
+	// (make rabbitmq_policies), x-dead-letter arguments would fail with PRECONDITION_FAILED on already declared queues
 	queue, err := ch.QueueDeclare(
 		queueName,
 		queueDurable,
@@ -86,22 +98,54 @@ func (c *UserConsumer) CreateExchangeAndQueue(exchangeName, queueName, bindingKe
 		return nil, errors.Wrap(err, "Error ch.QueueBind")
 	}
 
-	err = ch.Qos(
-		prefetchCount,  // prefetch count
-		prefetchSize,   // prefetch size
-		prefetchGlobal, // global
+	return ch, nil
+}
+
+// declareDeadLetterQueue declare dead letter exchange and queue for rejected deliveries of queueName
+func (c *UserConsumer) declareDeadLetterQueue(ch *amqp.Channel, queueName string) error {
+	c.logger.Infof("Declaring dead letter exchange: %s", deadLetterExchange)
+	if err := ch.ExchangeDeclare(
+		deadLetterExchange,
+		exchangeKind,
+		exchangeDurable,
+		exchangeAutoDelete,
+		exchangeInternal,
+		exchangeNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.ExchangeDeclare")
+	}
+
+	dlq, err := ch.QueueDeclare(
+		queueName+deadLetterQueueSuffix,
+		queueDurable,
+		queueAutoDelete,
+		queueExclusive,
+		queueNoWait,
+		nil,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error  ch.Qos")
+		return errors.Wrap(err, "Error ch.QueueDeclare")
 	}
 
-	return ch, nil
+	if err := ch.QueueBind(
+		dlq.Name,
+		queueName,
+		deadLetterExchange,
+		queueNoWait,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "Error ch.QueueBind")
+	}
+
+	return nil
 }
 
 func (c *UserConsumer) startConsume(
 	ctx context.Context,
 	worker func(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery),
 	workerPoolSize int,
+	prefetchCount int,
 	queueName string,
 	consumerTag string,
 ) error {
@@ -110,6 +154,15 @@ func (c *UserConsumer) startConsume(
 		return errors.Wrap(err, "c.amqpConn.Channel")
 	}
 
+	err = ch.Qos(
+		prefetchCount,  // prefetch count
+		prefetchSize,   // prefetch size
+		prefetchGlobal, // global
+	)
+	if err != nil {
+		return errors.Wrap(err, "Error  ch.Qos")
+	}
+
 	deliveries, err := ch.Consume(
 		queueName,
 		consumerTag,
@@ -123,6 +176,11 @@ func (c *UserConsumer) startConsume(
 		return errors.Wrap(err, "ch.Consume")
 	}
 
+	c.mu.Lock()
+	c.channels = append(c.channels, ch)
+	c.consumeChannels[consumerTag] = ch
+	c.mu.Unlock()
+
 	wg := &sync.WaitGroup{}
 
 	wg.Add(workerPoolSize)
@@ -130,6 +188,12 @@ func (c *UserConsumer) startConsume(
 		go worker(ctx, wg, deliveries)
 	}
 
+	c.workers.Add(1)
+	go func() {
+		defer c.workers.Done()
+		wg.Wait()
+	}()
+
 	chanErr := <-ch.NotifyClose(make(chan *amqp.Error))
 	c.logger.Errorf("ch.NotifyClose: %v", chanErr)
 
@@ -143,7 +207,8 @@ func (c *UserConsumer) RunConsumers(ctx context.Context, cancel context.CancelFu
 		if err := c.startConsume(
 			ctx,
 			c.imagesWorker,
-			AvatarsWorkers,
+			c.cfg.RabbitMQ.AvatarsConsumer.WorkerPoolSize,
+			c.cfg.RabbitMQ.AvatarsConsumer.PrefetchCount,
 			AvatarsQueueName,
 			AvatarsConsumerTag,
 		); err != nil {
@@ -151,5 +216,42 @@ func (c *UserConsumer) RunConsumers(ctx context.Context, cancel context.CancelFu
 			cancel()
 		}
 	}()
+}
 
+// CloseChannels close active channels
+func (c *UserConsumer) CloseChannels() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, channel := range c.channels {
+		go func(ch *amqp.Channel) {
+			if err := ch.Close(); err != nil {
+				c.logger.Errorf("CloseChannels ch.Close error: %v", err)
+			}
+		}(channel)
+	}
+}
+
+// Drain cancel consumers and wait until workers process and ack in-flight deliveries, must be called before CloseChannels
+func (c *UserConsumer) Drain(timeout time.Duration) {
+	c.mu.Lock()
+	for consumerTag, ch := range c.consumeChannels {
+		if err := ch.Cancel(consumerTag, consumeNoWait); err != nil {
+			c.logger.Errorf("Drain ch.Cancel: %v", err)
+		}
+	}
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("Consumers drained")
+	case <-time.After(timeout):
+		c.logger.Errorf("Consumers drain timeout: %v", timeout)
+	}
 }
@@ -10,32 +10,44 @@ import (
 
 func (c *UserConsumer) imagesWorker(ctx context.Context, wg *sync.WaitGroup, messages <-chan amqp.Delivery) {
 	defer wg.Done()
-
 	for delivery := range messages {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "ImageConsumer.resizeWorker")
-
-		c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
-
-		incomingMessages.Inc()
-
-		err := c.userUC.UpdateUploadedAvatar(ctx, delivery)
-		if err != nil {
-			if err := delivery.Reject(false); err != nil {
-				c.logger.Errorf("Err delivery.Reject: %v", err)
-			}
-			c.logger.Errorf("Failed to process delivery: %v", err)
-			errorMessages.Inc()
-		} else {
-			err = delivery.Ack(false)
-			if err != nil {
-				c.logger.Errorf("Failed to acknowledge delivery: %v", err)
-				errorMessages.Inc()
-				continue
-			}
-			successMessages.Inc()
-		}
-		span.Finish()
+		c.processDelivery(ctx, "ImageConsumer.resizeWorker", AvatarsQueueName, delivery, c.userUC.UpdateUploadedAvatar)
 	}
 
 	c.logger.Info("Deliveries channel closed")
 }
+
+// processDelivery ack processed delivery or reject it to dead letter queue,
+// span and busy workers gauge are finished once whatever the outcome is
+func (c *UserConsumer) processDelivery(
+	ctx context.Context,
+	operationName string,
+	queueName string,
+	delivery amqp.Delivery,
+	process func(ctx context.Context, delivery amqp.Delivery) error,
+) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, operationName)
+	defer span.Finish()
+
+	c.logger.Infof("processDeliveries deliveryTag% v", delivery.DeliveryTag)
+
+	incomingMessages.Inc()
+	busyWorkers.WithLabelValues(queueName).Inc()
+	defer busyWorkers.WithLabelValues(queueName).Dec()
+
+	if err := process(ctx, delivery); err != nil {
+		if err := delivery.Reject(false); err != nil {
+			c.logger.Errorf("Err delivery.Reject: %v", err)
+		}
+		c.logger.Errorf("Failed to process delivery: %v", err)
+		errorMessages.Inc()
+		return
+	}
+
+	if err := delivery.Ack(false); err != nil {
+		c.logger.Errorf("Failed to acknowledge delivery: %v", err)
+		errorMessages.Inc()
+		return
+	}
+	successMessages.Inc()
+}
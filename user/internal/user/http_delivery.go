@@ -13,4 +13,22 @@ type Delivery interface {
 	GetUserByID() echo.HandlerFunc
 	GetMe() echo.HandlerFunc
 	GetCSRFToken() echo.HandlerFunc
+	GetSessions() echo.HandlerFunc
+	RevokeSession() echo.HandlerFunc
+	RevokeOtherSessions() echo.HandlerFunc
+	ResendVerification() echo.HandlerFunc
+	ConfirmEmail() echo.HandlerFunc
+	ChangePassword() echo.HandlerFunc
+	ChangeEmail() echo.HandlerFunc
+	ForgotPassword() echo.HandlerFunc
+	ResetPassword() echo.HandlerFunc
+	Token() echo.HandlerFunc
+	RevokeToken() echo.HandlerFunc
+	OIDCLogin() echo.HandlerFunc
+	OIDCCallback() echo.HandlerFunc
+	LoginTwoFactor() echo.HandlerFunc
+	EnrollTwoFactor() echo.HandlerFunc
+	ConfirmTwoFactor() echo.HandlerFunc
+	DisableTwoFactor() echo.HandlerFunc
+	RegenerateRecoveryCodes() echo.HandlerFunc
 }
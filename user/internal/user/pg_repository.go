@@ -15,5 +15,24 @@ type PGRepository interface {
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
 	Update(ctx context.Context, user *models.UserUpdate) (*models.UserResponse, error)
 	UpdateAvatar(ctx context.Context, msg models.UploadedImageMsg) (*models.UserResponse, error)
-	GetUsersByIDs(ctx context.Context, userIDs []string) ([]*models.UserResponse, error)
+	GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserResponse, error)
+	GetByIDWithPassword(ctx context.Context, userID uuid.UUID) (*models.User, error)
+	UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	UpdateEmail(ctx context.Context, userID uuid.UUID, email string) (*models.UserResponse, error)
+	VerifyEmail(ctx context.Context, userID uuid.UUID) (*models.UserResponse, error)
+	GetByIdentity(ctx context.Context, provider string, subject string) (*models.UserResponse, error)
+	CreateIdentity(ctx context.Context, identity *models.UserIdentity) (*models.UserIdentity, error)
+	CreateWithIdentity(ctx context.Context, user *models.User, identity *models.UserIdentity) (*models.UserResponse, error)
+	GetTwoFactor(ctx context.Context, userID uuid.UUID) (*models.TwoFactor, error)
+	SetTwoFactorSecret(ctx context.Context, userID uuid.UUID, secret string) error
+	EnableTwoFactor(ctx context.Context, userID uuid.UUID, step int64, codeHashes []string) error
+	DisableTwoFactor(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	ListUsers(ctx context.Context, query *models.UsersQuery) (*models.UsersList, error)
+	UpdateRole(ctx context.Context, userID uuid.UUID, role models.Role) (*models.AdminUser, error)
+	Suspend(ctx context.Context, userID uuid.UUID, reason string) (*models.AdminUser, error)
+	Unsuspend(ctx context.Context, userID uuid.UUID) (*models.AdminUser, error)
+	Anonymize(ctx context.Context, userID uuid.UUID) error
 }
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 
@@ -14,3 +15,24 @@ type RedisRepository interface {
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*models.UserResponse, error)
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
 }
+
+// TokenRepository single use time limited tokens, password reset and email verification
+type TokenRepository interface {
+	SaveToken(ctx context.Context, token string, userID uuid.UUID) error
+	ConsumeToken(ctx context.Context, token string) (uuid.UUID, error)
+}
+
+// OIDCStateRepository single use OIDC authorization request state
+type OIDCStateRepository interface {
+	SaveState(ctx context.Context, state string, oidcState *models.OIDCState) error
+	ConsumeState(ctx context.Context, state string) (*models.OIDCState, error)
+}
+
+// LoginAttemptsRepository failed login counters per email and ip, temporary email lockouts
+type LoginAttemptsRepository interface {
+	GetAttempts(ctx context.Context, email string, ip string) (*models.LoginAttempts, error)
+	RegisterFailure(ctx context.Context, email string, ip string) (*models.LoginAttempts, error)
+	Lock(ctx context.Context, email string) error
+	Throttle(ctx context.Context, email string, delay time.Duration) error
+	Reset(ctx context.Context, email string) error
+}
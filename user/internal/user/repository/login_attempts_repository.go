@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
+)
+
+type loginAttemptsRedisRepository struct {
+	redisConn *redis.Client
+	prefix    string
+	window    time.Duration
+	lockout   time.Duration
+}
+
+// NewLoginAttemptsRedisRepository failed login counters expire after window without new failures
+func NewLoginAttemptsRedisRepository(
+	redisConn *redis.Client,
+	prefix string,
+	window time.Duration,
+	lockout time.Duration,
+) *loginAttemptsRedisRepository {
+	return &loginAttemptsRedisRepository{redisConn: redisConn, prefix: prefix, window: window, lockout: lockout}
+}
+
+// GetAttempts current failures of email and ip, remaining email lockout and throttling delay
+func (r *loginAttemptsRedisRepository) GetAttempts(ctx context.Context, email string, ip string) (*models.LoginAttempts, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "loginAttemptsRedisRepository.GetAttempts")
+	defer span.Finish()
+
+	var emailFailures, ipFailures *redis.StringCmd
+	var lockTTL, throttleTTL *redis.DurationCmd
+	if _, err := r.redisConn.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		emailFailures = pipe.Get(ctx, r.emailKey(email))
+		ipFailures = pipe.Get(ctx, r.ipKey(ip))
+		lockTTL = pipe.PTTL(ctx, r.lockKey(email))
+		throttleTTL = pipe.PTTL(ctx, r.throttleKey(email))
+		return nil
+	}); err != nil && err != redis.Nil {
+		return nil, errors.Wrap(err, "loginAttemptsRedisRepository.GetAttempts.redisConn.Pipelined")
+	}
+
+	attempts := &models.LoginAttempts{LockedFor: remainingTTL(lockTTL), ThrottledFor: remainingTTL(throttleTTL)}
+
+	var err error
+	if attempts.EmailFailures, err = counterValue(emailFailures); err != nil {
+		return nil, errors.Wrap(err, "emailFailures")
+	}
+	if attempts.IPFailures, err = counterValue(ipFailures); err != nil {
+		return nil, errors.Wrap(err, "ipFailures")
+	}
+
+	return attempts, nil
+}
+
+// RegisterFailure increment email and ip counters, window slides with each failure
+func (r *loginAttemptsRedisRepository) RegisterFailure(ctx context.Context, email string, ip string) (*models.LoginAttempts, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "loginAttemptsRedisRepository.RegisterFailure")
+	defer span.Finish()
+
+	var emailFailures, ipFailures *redis.IntCmd
+	if _, err := r.redisConn.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		emailFailures = pipe.Incr(ctx, r.emailKey(email))
+		pipe.Expire(ctx, r.emailKey(email), r.window)
+		ipFailures = pipe.Incr(ctx, r.ipKey(ip))
+		pipe.Expire(ctx, r.ipKey(ip), r.window)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "loginAttemptsRedisRepository.RegisterFailure.redisConn.TxPipelined")
+	}
+
+	return &models.LoginAttempts{EmailFailures: emailFailures.Val(), IPFailures: ipFailures.Val()}, nil
+}
+
+// Lock lock email for lockout duration, failures counter starts again after lockout
+func (r *loginAttemptsRedisRepository) Lock(ctx context.Context, email string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "loginAttemptsRedisRepository.Lock")
+	defer span.Finish()
+
+	if _, err := r.redisConn.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetEX(ctx, r.lockKey(email), time.Now().UTC().Format(time.RFC3339), r.lockout)
+		pipe.Del(ctx, r.emailKey(email))
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "loginAttemptsRedisRepository.Lock.redisConn.TxPipelined")
+	}
+
+	return nil
+}
+
+// Throttle reject login attempts of email until delay passes
+func (r *loginAttemptsRedisRepository) Throttle(ctx context.Context, email string, delay time.Duration) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "loginAttemptsRedisRepository.Throttle")
+	defer span.Finish()
+
+	if err := r.redisConn.Set(ctx, r.throttleKey(email), time.Now().UTC().Format(time.RFC3339), delay).Err(); err != nil {
+		return errors.Wrap(err, "loginAttemptsRedisRepository.Throttle.redisConn.Set")
+	}
+
+	return nil
+}
+
+// Reset drop email failures, lockout and throttling, ip counter is kept to slow down password spraying
+func (r *loginAttemptsRedisRepository) Reset(ctx context.Context, email string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "loginAttemptsRedisRepository.Reset")
+	defer span.Finish()
+
+	if err := r.redisConn.Del(ctx, r.emailKey(email), r.lockKey(email), r.throttleKey(email)).Err(); err != nil {
+		return errors.Wrap(err, "loginAttemptsRedisRepository.Reset.redisConn.Del")
+	}
+
+	return nil
+}
+
+func counterValue(cmd *redis.StringCmd) (int64, error) {
+	value, err := cmd.Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return value, err
+}
+
+// remainingTTL key ttl, missing key or key without expiration returns negative ttl
+func remainingTTL(cmd *redis.DurationCmd) time.Duration {
+	if ttl := cmd.Val(); ttl > 0 {
+		return ttl
+	}
+	return 0
+}
+
+// emails are hashed, so redis keys don't store user identifiers in plain text
+func (r *loginAttemptsRedisRepository) emailKey(email string) string {
+	return fmt.Sprintf("%s_email: %s", r.prefix, hashEmail(email))
+}
+
+func (r *loginAttemptsRedisRepository) lockKey(email string) string {
+	return fmt.Sprintf("%s_lock: %s", r.prefix, hashEmail(email))
+}
+
+func (r *loginAttemptsRedisRepository) throttleKey(email string) string {
+	return fmt.Sprintf("%s_throttle: %s", r.prefix, hashEmail(email))
+}
+
+func (r *loginAttemptsRedisRepository) ipKey(ip string) string {
+	return fmt.Sprintf("%s_ip: %s", r.prefix, ip)
+}
+
+func hashEmail(email string) string {
+	hash := sha256.Sum256([]byte(email))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
+)
+
+type oidcStateRedisRepository struct {
+	redisConn  *redis.Client
+	prefix     string
+	expiration time.Duration
+}
+
+// NewOIDCStateRedisRepository pending OIDC authorization requests by state
+func NewOIDCStateRedisRepository(redisConn *redis.Client, prefix string, expiration time.Duration) *oidcStateRedisRepository {
+	return &oidcStateRedisRepository{redisConn: redisConn, prefix: prefix, expiration: expiration}
+}
+
+// SaveState store PKCE code verifier and nonce of authorization request
+func (r *oidcStateRedisRepository) SaveState(ctx context.Context, state string, oidcState *models.OIDCState) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "oidcStateRedisRepository.SaveState")
+	defer span.Finish()
+
+	stateBytes, err := json.Marshal(oidcState)
+	if err != nil {
+		return errors.Wrap(err, "oidcStateRedisRepository.SaveState.json.Marshal")
+	}
+
+	if err := r.redisConn.SetEX(ctx, r.createKey(state), stateBytes, r.expiration).Err(); err != nil {
+		return errors.Wrap(err, "oidcStateRedisRepository.SaveState.redisConn.SetEX")
+	}
+
+	return nil
+}
+
+// ConsumeState get and delete state in one transaction, callback can be handled only once
+func (r *oidcStateRedisRepository) ConsumeState(ctx context.Context, state string) (*models.OIDCState, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "oidcStateRedisRepository.ConsumeState")
+	defer span.Finish()
+
+	var get *redis.StringCmd
+	if _, err := r.redisConn.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, r.createKey(state))
+		pipe.Del(ctx, r.createKey(state))
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "oidcStateRedisRepository.ConsumeState.redisConn.TxPipelined")
+	}
+
+	var oidcState models.OIDCState
+	if err := json.Unmarshal([]byte(get.Val()), &oidcState); err != nil {
+		return nil, errors.Wrap(err, "oidcStateRedisRepository.ConsumeState.json.Unmarshal")
+	}
+
+	return &oidcState, nil
+}
+
+func (r *oidcStateRedisRepository) createKey(state string) string {
+	hash := sha256.Sum256([]byte(state))
+	return fmt.Sprintf("%s: %s", r.prefix, hex.EncodeToString(hash[:]))
+}
@@ -2,16 +2,17 @@ package repository
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 	"log"
+	"strings"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
-	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/utils"
 )
 
 type userPGRepository struct {
@@ -38,7 +39,9 @@ func (u *userPGRepository) Create(ctx context.Context, user *models.User) (*mode
 		&user.Avatar,
 		&user.Role,
 	).Scan(&created.UserID, &created.FirstName, &created.LastName, &created.Email,
-		&created.Avatar, &created.Role, &created.UpdatedAt, &created.CreatedAt,
+		&created.Avatar, &created.Role, &created.UpdatedAt, &created.CreatedAt, &created.EmailVerifiedAt,
+		&created.TwoFactorEnabledAt,
+		&created.SuspendedAt,
 	); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
@@ -61,6 +64,9 @@ func (u *userPGRepository) GetByID(ctx context.Context, userID uuid.UUID) (*mode
 		&res.Role,
 		&res.UpdatedAt,
 		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
 	); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
@@ -84,6 +90,9 @@ func (u *userPGRepository) GetByEmail(ctx context.Context, email string) (*model
 		&res.Role,
 		&res.UpdatedAt,
 		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
 	); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
@@ -97,7 +106,7 @@ func (u *userPGRepository) Update(ctx context.Context, user *models.UserUpdate)
 	defer span.Finish()
 
 	var res models.UserResponse
-	if err := u.db.QueryRow(ctx, updateUserQuery, &user.FirstName, &user.LastName, &user.Email, &user.Role, &user.UserID).
+	if err := u.db.QueryRow(ctx, updateUserQuery, &user.FirstName, &user.LastName, &user.UserID).
 		Scan(
 			&res.UserID,
 			&res.FirstName,
@@ -107,6 +116,9 @@ func (u *userPGRepository) Update(ctx context.Context, user *models.UserUpdate)
 			&res.Avatar,
 			&res.UpdatedAt,
 			&res.CreatedAt,
+			&res.EmailVerifiedAt,
+			&res.TwoFactorEnabledAt,
+			&res.SuspendedAt,
 		); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
@@ -129,6 +141,9 @@ func (u *userPGRepository) UpdateAvatar(ctx context.Context, msg models.Uploaded
 		&res.Avatar,
 		&res.UpdatedAt,
 		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
 	); err != nil {
 		return nil, errors.Wrap(err, "Scan")
 	}
@@ -136,19 +151,20 @@ func (u *userPGRepository) UpdateAvatar(ctx context.Context, msg models.Uploaded
 	return &res, nil
 }
 
-func (u *userPGRepository) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*models.UserResponse, error) {
+func (u *userPGRepository) GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.GetUsersByIDs")
 	defer span.Finish()
 
-	placeholders := utils.CreateSQLPlaceholders(len(userIDs))
-	query := fmt.Sprintf("SELECT user_id, first_name, last_name, email, avatar, role, updated_at, created_at FROM users WHERE user_id IN (%v)", placeholders)
+	if len(userIDs) == 0 {
+		return make([]*models.UserResponse, 0), nil
+	}
 
-	args := make([]interface{}, len(userIDs))
-	for i, id := range userIDs {
-		args[i] = id
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.String())
 	}
 
-	rows, err := u.db.Query(ctx, query, args...)
+	rows, err := u.db.Query(ctx, getUsersByIDsQuery, ids)
 	if err != nil {
 		return nil, errors.Wrap(err, "db.Query")
 	}
@@ -166,11 +182,484 @@ func (u *userPGRepository) GetUsersByIDs(ctx context.Context, userIDs []string)
 			&res.Role,
 			&res.UpdatedAt,
 			&res.CreatedAt,
+			&res.EmailVerifiedAt,
+			&res.TwoFactorEnabledAt,
+			&res.SuspendedAt,
 		); err != nil {
-			return nil, errors.Wrap(err, "db.Query")
+			return nil, errors.Wrap(err, "rows.Scan")
 		}
 		users = append(users, &res)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+
 	return users, nil
 }
+
+// UpdatePassword set new password hash
+func (u *userPGRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.UpdatePassword")
+	defer span.Finish()
+
+	result, err := u.db.Exec(ctx, updatePasswordQuery, passwordHash, userID)
+	if err != nil {
+		return errors.Wrap(err, "db.Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return errors.Wrap(sql.ErrNoRows, "db.Exec")
+	}
+
+	return nil
+}
+
+// VerifyEmail mark user email as verified, keeps first verification time
+func (u *userPGRepository) VerifyEmail(ctx context.Context, userID uuid.UUID) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.VerifyEmail")
+	defer span.Finish()
+
+	var res models.UserResponse
+	if err := u.db.QueryRow(ctx, verifyEmailQuery, userID).Scan(
+		&res.UserID,
+		&res.FirstName,
+		&res.LastName,
+		&res.Email,
+		&res.Role,
+		&res.Avatar,
+		&res.UpdatedAt,
+		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &res, nil
+}
+
+// GetByIDWithPassword user with password hash, used to re-authenticate sensitive changes
+func (u *userPGRepository) GetByIDWithPassword(ctx context.Context, userID uuid.UUID) (*models.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.GetByIDWithPassword")
+	defer span.Finish()
+
+	var res models.User
+	if err := u.db.QueryRow(ctx, getUserWithPasswordByIDQuery, userID).Scan(
+		&res.UserID,
+		&res.FirstName,
+		&res.LastName,
+		&res.Email,
+		&res.Password,
+		&res.Avatar,
+		&res.Role,
+		&res.UpdatedAt,
+		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &res, nil
+}
+
+// UpdateEmail set new email, it must be verified again
+func (u *userPGRepository) UpdateEmail(ctx context.Context, userID uuid.UUID, email string) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.UpdateEmail")
+	defer span.Finish()
+
+	var res models.UserResponse
+	if err := u.db.QueryRow(ctx, updateEmailQuery, email, userID).Scan(
+		&res.UserID,
+		&res.FirstName,
+		&res.LastName,
+		&res.Email,
+		&res.Role,
+		&res.Avatar,
+		&res.UpdatedAt,
+		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &res, nil
+}
+
+// GetByIdentity get user linked to external provider account
+func (u *userPGRepository) GetByIdentity(ctx context.Context, provider string, subject string) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.GetByIdentity")
+	defer span.Finish()
+
+	var res models.UserResponse
+	if err := u.db.QueryRow(ctx, getUserByIdentityQuery, provider, subject).Scan(
+		&res.UserID,
+		&res.FirstName,
+		&res.LastName,
+		&res.Email,
+		&res.Avatar,
+		&res.Role,
+		&res.UpdatedAt,
+		&res.CreatedAt,
+		&res.EmailVerifiedAt,
+		&res.TwoFactorEnabledAt,
+		&res.SuspendedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &res, nil
+}
+
+// CreateIdentity link external provider account to existing user
+func (u *userPGRepository) CreateIdentity(ctx context.Context, identity *models.UserIdentity) (*models.UserIdentity, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.CreateIdentity")
+	defer span.Finish()
+
+	if err := u.db.QueryRow(
+		ctx,
+		createIdentityQuery,
+		identity.UserID,
+		identity.Provider,
+		identity.Subject,
+		identity.Email,
+	).Scan(&identity.IdentityID, &identity.CreatedAt); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return identity, nil
+}
+
+// CreateWithIdentity create user and link external provider account in one transaction
+func (u *userPGRepository) CreateWithIdentity(ctx context.Context, user *models.User, identity *models.UserIdentity) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.CreateWithIdentity")
+	defer span.Finish()
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Begin")
+	}
+	defer tx.Rollback(ctx)
+
+	var created models.UserResponse
+	if err := tx.QueryRow(
+		ctx,
+		createUserWithVerifiedEmailQuery,
+		&user.FirstName,
+		&user.LastName,
+		&user.Email,
+		&user.Password,
+		&user.Role,
+		&user.EmailVerifiedAt,
+	).Scan(&created.UserID, &created.FirstName, &created.LastName, &created.Email,
+		&created.Avatar, &created.Role, &created.UpdatedAt, &created.CreatedAt, &created.EmailVerifiedAt,
+		&created.TwoFactorEnabledAt,
+		&created.SuspendedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	if err := tx.QueryRow(
+		ctx,
+		createIdentityQuery,
+		created.UserID,
+		identity.Provider,
+		identity.Subject,
+		identity.Email,
+	).Scan(&identity.IdentityID, &identity.CreatedAt); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(err, "tx.Commit")
+	}
+	identity.UserID = created.UserID
+
+	return &created, nil
+}
+
+// GetTwoFactor get TOTP state of user
+func (u *userPGRepository) GetTwoFactor(ctx context.Context, userID uuid.UUID) (*models.TwoFactor, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.GetTwoFactor")
+	defer span.Finish()
+
+	var res models.TwoFactor
+	if err := u.db.QueryRow(ctx, getTwoFactorQuery, userID).Scan(&res.Secret, &res.EnabledAt, &res.LastStep); err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return &res, nil
+}
+
+// SetTwoFactorSecret store new not yet confirmed secret, enabled two-factor can't be overwritten
+func (u *userPGRepository) SetTwoFactorSecret(ctx context.Context, userID uuid.UUID, secret string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.SetTwoFactorSecret")
+	defer span.Finish()
+
+	result, err := u.db.Exec(ctx, setTwoFactorSecretQuery, secret, userID)
+	if err != nil {
+		return errors.Wrap(err, "db.Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return errors.Wrap(sql.ErrNoRows, "db.Exec")
+	}
+
+	return nil
+}
+
+// EnableTwoFactor confirm secret with verified step and replace recovery codes in one transaction
+func (u *userPGRepository) EnableTwoFactor(ctx context.Context, userID uuid.UUID, step int64, codeHashes []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.EnableTwoFactor")
+	defer span.Finish()
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "db.Begin")
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, enableTwoFactorQuery, step, userID)
+	if err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return errors.Wrap(sql.ErrNoRows, "tx.Exec")
+	}
+
+	if _, err := tx.Exec(ctx, deleteRecoveryCodesQuery, userID); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+	if _, err := tx.Exec(ctx, createRecoveryCodesQuery, userID, codeHashes); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "tx.Commit")
+	}
+
+	return nil
+}
+
+// DisableTwoFactor remove secret and recovery codes in one transaction
+func (u *userPGRepository) DisableTwoFactor(ctx context.Context, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.DisableTwoFactor")
+	defer span.Finish()
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "db.Begin")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, disableTwoFactorQuery, userID); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+	if _, err := tx.Exec(ctx, deleteRecoveryCodesQuery, userID); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "tx.Commit")
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes
+func (u *userPGRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.ReplaceRecoveryCodes")
+	defer span.Finish()
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "db.Begin")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, deleteRecoveryCodesQuery, userID); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+	if _, err := tx.Exec(ctx, createRecoveryCodesQuery, userID, codeHashes); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "tx.Commit")
+	}
+
+	return nil
+}
+
+// UseTwoFactorStep mark TOTP step as used, returns false for already used or older step
+func (u *userPGRepository) UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.UseTwoFactorStep")
+	defer span.Finish()
+
+	result, err := u.db.Exec(ctx, useTwoFactorStepQuery, step, userID)
+	if err != nil {
+		return false, errors.Wrap(err, "db.Exec")
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// UseRecoveryCode mark recovery code as used, returns false for unknown or already used code
+func (u *userPGRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.UseRecoveryCode")
+	defer span.Finish()
+
+	result, err := u.db.Exec(ctx, useRecoveryCodeQuery, userID, codeHash)
+	if err != nil {
+		return false, errors.Wrap(err, "db.Exec")
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// ListUsers get users page with account management state
+func (u *userPGRepository) ListUsers(ctx context.Context, query *models.UsersQuery) (*models.UsersList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.ListUsers")
+	defer span.Finish()
+
+	search := ""
+	if query.Search != "" {
+		search = "%" + likeEscaper.Replace(query.Search) + "%"
+	}
+
+	var totalCount int
+	if err := u.db.QueryRow(ctx, countUsersQuery, search, query.Role, query.Status).Scan(&totalCount); err != nil {
+		return nil, errors.Wrap(err, "db.QueryRow")
+	}
+	if totalCount == 0 {
+		return models.NewUsersList(query, totalCount, make([]*models.AdminUser, 0)), nil
+	}
+
+	rows, err := u.db.Query(
+		ctx,
+		listUsersQuery,
+		search,
+		query.Role,
+		query.Status,
+		query.GetOffset(),
+		query.GetSize(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	users := make([]*models.AdminUser, 0, query.GetSize())
+	for rows.Next() {
+		user, err := scanAdminUser(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "rows.Scan")
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+
+	return models.NewUsersList(query, totalCount, users), nil
+}
+
+// UpdateRole change role of not deleted user
+func (u *userPGRepository) UpdateRole(ctx context.Context, userID uuid.UUID, role models.Role) (*models.AdminUser, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.UpdateRole")
+	defer span.Finish()
+
+	user, err := scanAdminUser(u.db.QueryRow(ctx, updateUserRoleQuery, role, userID))
+	if err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return user, nil
+}
+
+// Suspend mark not deleted user suspended
+func (u *userPGRepository) Suspend(ctx context.Context, userID uuid.UUID, reason string) (*models.AdminUser, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.Suspend")
+	defer span.Finish()
+
+	user, err := scanAdminUser(u.db.QueryRow(ctx, suspendUserQuery, reason, userID))
+	if err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return user, nil
+}
+
+// Unsuspend clear suspension of not deleted user
+func (u *userPGRepository) Unsuspend(ctx context.Context, userID uuid.UUID) (*models.AdminUser, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.Unsuspend")
+	defer span.Finish()
+
+	user, err := scanAdminUser(u.db.QueryRow(ctx, unsuspendUserQuery, userID))
+	if err != nil {
+		return nil, errors.Wrap(err, "Scan")
+	}
+
+	return user, nil
+}
+
+// Anonymize soft delete user, personal data, credentials and linked identities are removed,
+// the row is kept so hotels and comments still reference an existing user
+func (u *userPGRepository) Anonymize(ctx context.Context, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userPGRepository.Anonymize")
+	defer span.Finish()
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "db.Begin")
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, anonymizeUserQuery, userID)
+	if err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+	if result.RowsAffected() == 0 {
+		return errors.Wrap(sql.ErrNoRows, "tx.Exec")
+	}
+
+	if _, err := tx.Exec(ctx, deleteIdentitiesQuery, userID); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+	if _, err := tx.Exec(ctx, deleteRecoveryCodesQuery, userID); err != nil {
+		return errors.Wrap(err, "tx.Exec")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "tx.Commit")
+	}
+
+	return nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func scanAdminUser(row pgx.Row) (*models.AdminUser, error) {
+	var user models.AdminUser
+	if err := row.Scan(
+		&user.UserID,
+		&user.FirstName,
+		&user.LastName,
+		&user.Email,
+		&user.Avatar,
+		&user.Role,
+		&user.UpdatedAt,
+		&user.CreatedAt,
+		&user.EmailVerifiedAt,
+		&user.TwoFactorEnabledAt,
+		&user.SuspendedAt,
+		&user.SuspensionReason,
+		&user.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -3,21 +3,102 @@ package repository
 const (
 	createUserQuery = `INSERT INTO users (first_name, last_name, email, password, avatar, role) 
 	VALUES ($1,$2,$3,$4,$5,$6) 
-	RETURNING user_id, first_name, last_name, email, avatar, role, updated_at, created_at`
+	RETURNING user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at`
 
-	getUserByIDQuery = `SELECT user_id, first_name, last_name, email, avatar, role, updated_at, created_at FROM users WHERE user_id = $1`
+	getUserByIDQuery = `SELECT user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at FROM users WHERE user_id = $1`
 
-	getUserByEmail = `SELECT user_id, first_name, last_name, email, password, avatar, role, updated_at, created_at 
+	getUserByEmail = `SELECT user_id, first_name, last_name, email, password, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at 
 	FROM users WHERE email = $1`
 
 	updateUserQuery = `UPDATE users 
 		SET first_name = COALESCE(NULLIF($1, ''), first_name), 
-	    last_name = COALESCE(NULLIF($2, ''), last_name), 
-	    email = COALESCE(NULLIF($3, ''), email), 
-	    role = COALESCE(NULLIF($4, '')::role, role)
-		WHERE user_id = $5
-	    RETURNING user_id, first_name, last_name, email, role, avatar, updated_at, created_at`
+	    last_name = COALESCE(NULLIF($2, ''), last_name)
+		WHERE user_id = $3
+	    RETURNING user_id, first_name, last_name, email, role, avatar, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at`
+
+	getUsersByIDsQuery = `SELECT user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at 
+	FROM users WHERE user_id = ANY($1::uuid[])`
+
+	getUserWithPasswordByIDQuery = `SELECT user_id, first_name, last_name, email, password, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at 
+	FROM users WHERE user_id = $1`
+
+	updateEmailQuery = `UPDATE users SET email = $1, email_verified_at = NULL WHERE user_id = $2 
+	RETURNING user_id, first_name, last_name, email, role, avatar, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at`
+
+	verifyEmailQuery = `UPDATE users SET email_verified_at = COALESCE(email_verified_at, now()) WHERE user_id = $1 
+	RETURNING user_id, first_name, last_name, email, role, avatar, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at`
+
+	updatePasswordQuery = `UPDATE users SET password = $1 WHERE user_id = $2`
 
 	updateAvatarQuery = `UPDATE users SET avatar = $1 WHERE user_id = $2 
-	RETURNING user_id, first_name, last_name, email, role, avatar, updated_at, created_at`
+	RETURNING user_id, first_name, last_name, email, role, avatar, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at`
+
+	getUserByIdentityQuery = `SELECT u.user_id, u.first_name, u.last_name, u.email, u.avatar, u.role, u.updated_at, u.created_at, u.email_verified_at, u.two_factor_enabled_at, u.suspended_at 
+	FROM users u JOIN user_identities i ON i.user_id = u.user_id WHERE i.provider = $1 AND i.subject = $2`
+
+	createUserWithVerifiedEmailQuery = `INSERT INTO users (first_name, last_name, email, password, role, email_verified_at) 
+	VALUES ($1,$2,$3,$4,$5,$6) 
+	RETURNING user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at`
+
+	createIdentityQuery = `INSERT INTO user_identities (user_id, provider, subject, email) VALUES ($1,$2,$3,$4) 
+	RETURNING identity_id, created_at`
+
+	getTwoFactorQuery = `SELECT two_factor_secret, two_factor_enabled_at, two_factor_last_step FROM users WHERE user_id = $1`
+
+	setTwoFactorSecretQuery = `UPDATE users SET two_factor_secret = $1, two_factor_last_step = NULL 
+	WHERE user_id = $2 AND two_factor_enabled_at IS NULL`
+
+	enableTwoFactorQuery = `UPDATE users SET two_factor_enabled_at = now(), two_factor_last_step = $1 
+	WHERE user_id = $2 AND two_factor_secret IS NOT NULL AND two_factor_enabled_at IS NULL`
+
+	disableTwoFactorQuery = `UPDATE users SET two_factor_secret = NULL, two_factor_enabled_at = NULL, two_factor_last_step = NULL 
+	WHERE user_id = $1`
+
+	useTwoFactorStepQuery = `UPDATE users SET two_factor_last_step = $1 
+	WHERE user_id = $2 AND (two_factor_last_step IS NULL OR two_factor_last_step < $1)`
+
+	deleteRecoveryCodesQuery = `DELETE FROM user_recovery_codes WHERE user_id = $1`
+
+	createRecoveryCodesQuery = `INSERT INTO user_recovery_codes (user_id, code_hash) SELECT $1, unnest($2::text[])`
+
+	useRecoveryCodeQuery = `UPDATE user_recovery_codes SET used_at = now() 
+	WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+
+	countUsersQuery = `SELECT count(user_id) FROM users 
+	WHERE ($1::text = '' OR email ILIKE $1 OR first_name ILIKE $1 OR last_name ILIKE $1) 
+	AND ($2::text = '' OR role::text = $2) 
+	AND ($3::text = '' 
+		OR ($3 = 'active' AND suspended_at IS NULL AND deleted_at IS NULL) 
+		OR ($3 = 'suspended' AND suspended_at IS NOT NULL) 
+		OR ($3 = 'deleted' AND deleted_at IS NOT NULL))`
+
+	listUsersQuery = `SELECT user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at, suspension_reason, deleted_at 
+	FROM users 
+	WHERE ($1::text = '' OR email ILIKE $1 OR first_name ILIKE $1 OR last_name ILIKE $1) 
+	AND ($2::text = '' OR role::text = $2) 
+	AND ($3::text = '' 
+		OR ($3 = 'active' AND suspended_at IS NULL AND deleted_at IS NULL) 
+		OR ($3 = 'suspended' AND suspended_at IS NOT NULL) 
+		OR ($3 = 'deleted' AND deleted_at IS NOT NULL)) 
+	ORDER BY created_at DESC, user_id DESC OFFSET $4 LIMIT $5`
+
+	updateUserRoleQuery = `UPDATE users SET role = $1::role 
+	WHERE user_id = $2 AND deleted_at IS NULL 
+	RETURNING user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at, suspension_reason, deleted_at`
+
+	suspendUserQuery = `UPDATE users SET suspended_at = now(), suspension_reason = $1 
+	WHERE user_id = $2 AND deleted_at IS NULL 
+	RETURNING user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at, suspension_reason, deleted_at`
+
+	unsuspendUserQuery = `UPDATE users SET suspended_at = NULL, suspension_reason = NULL 
+	WHERE user_id = $1 AND deleted_at IS NULL 
+	RETURNING user_id, first_name, last_name, email, avatar, role, updated_at, created_at, email_verified_at, two_factor_enabled_at, suspended_at, suspension_reason, deleted_at`
+
+	deleteIdentitiesQuery = `DELETE FROM user_identities WHERE user_id = $1`
+
+	anonymizeUserQuery = `UPDATE users SET first_name = 'Deleted', last_name = 'User', 
+	    email = 'deleted-' || user_id || '@deleted.invalid', password = '!', avatar = NULL, role = 'user', 
+	    email_verified_at = NULL, two_factor_secret = NULL, two_factor_enabled_at = NULL, two_factor_last_step = NULL, 
+	    suspended_at = NULL, suspension_reason = NULL, deleted_at = now() 
+	WHERE user_id = $1 AND deleted_at IS NULL`
 )
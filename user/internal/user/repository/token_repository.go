@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+type tokenRedisRepository struct {
+	redisConn  *redis.Client
+	prefix     string
+	expiration time.Duration
+}
+
+// NewTokenRedisRepository single use tokens bound to user, prefix separates token kinds
+func NewTokenRedisRepository(redisConn *redis.Client, prefix string, expiration time.Duration) *tokenRedisRepository {
+	return &tokenRedisRepository{redisConn: redisConn, prefix: prefix, expiration: expiration}
+}
+
+// SaveToken store token hash only, so leaked redis keys can't be used as tokens
+func (r *tokenRedisRepository) SaveToken(ctx context.Context, token string, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "tokenRedisRepository.SaveToken")
+	defer span.Finish()
+
+	if err := r.redisConn.SetEX(ctx, r.createKey(token), userID.String(), r.expiration).Err(); err != nil {
+		return errors.Wrap(err, "tokenRedisRepository.SaveToken.redisConn.SetEX")
+	}
+
+	return nil
+}
+
+// ConsumeToken get and delete token in one transaction, token can be used only once
+func (r *tokenRedisRepository) ConsumeToken(ctx context.Context, token string) (uuid.UUID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "tokenRedisRepository.ConsumeToken")
+	defer span.Finish()
+
+	var get *redis.StringCmd
+	if _, err := r.redisConn.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, r.createKey(token))
+		pipe.Del(ctx, r.createKey(token))
+		return nil
+	}); err != nil {
+		return uuid.Nil, errors.Wrap(err, "tokenRedisRepository.ConsumeToken.redisConn.TxPipelined")
+	}
+
+	userID, err := uuid.FromString(get.Val())
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "uuid.FromString")
+	}
+
+	return userID, nil
+}
+
+func (r *tokenRedisRepository) createKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("%s: %s", r.prefix, hex.EncodeToString(hash[:]))
+}
@@ -12,14 +12,41 @@ import (
 // UseCase
 type UseCase interface {
 	Register(ctx context.Context, user *models.User) (*models.UserResponse, error)
-	Login(ctx context.Context, login models.Login) (*models.User, error)
+	Login(ctx context.Context, login models.Login, ip string) (*models.User, error)
 	GetByID(ctx context.Context, userID uuid.UUID) (*models.UserResponse, error)
-	CreateSession(ctx context.Context, userID uuid.UUID) (string, error)
+	CreateSession(ctx context.Context, userID uuid.UUID, userAgent string, ip string) (*models.Session, error)
 	GetSessionByID(ctx context.Context, sessionID string) (*models.Session, error)
 	GetCSRFToken(ctx context.Context, sessionID string) (string, error)
+	CheckCSRFToken(ctx context.Context, sessionID string, token string) (bool, error)
 	DeleteSession(ctx context.Context, sessionID string) error
+	ListSessions(ctx context.Context) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, deviceID string) error
+	RevokeOtherSessions(ctx context.Context) (int64, error)
 	Update(ctx context.Context, user *models.UserUpdate) (*models.UserResponse, error)
 	UpdateUploadedAvatar(ctx context.Context, delivery amqp.Delivery) error
 	UpdateAvatar(ctx context.Context, data *models.UpdateAvatarMsg) error
-	GetUsersByIDs(ctx context.Context, userIDs []string) ([]*models.UserResponse, error)
+	GetUsersByIDs(ctx context.Context, userIDs []string) (*models.UsersByIDs, error)
+	StreamUsersByIDs(ctx context.Context, userIDs []string, send func(users *models.UsersByIDs) error) error
+	ResendVerification(ctx context.Context) error
+	ConfirmEmail(ctx context.Context, token string) (*models.UserResponse, error)
+	ChangePassword(ctx context.Context, change *models.ChangePassword) error
+	ChangeEmail(ctx context.Context, change *models.ChangeEmail) (*models.UserResponse, error)
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, reset *models.ResetPassword) error
+	IssueToken(ctx context.Context, req *models.TokenRequest, userAgent string, ip string) (*models.TokenResponse, *models.TwoFactorChallenge, error)
+	RevokeRefreshToken(ctx context.Context, refreshToken string) error
+	OIDCAuthURL(ctx context.Context, providerName string) (string, string, error)
+	OIDCCallback(ctx context.Context, providerName string, callback *models.OIDCCallback) (*models.UserResponse, error)
+	CreatePendingLogin(ctx context.Context, userID uuid.UUID, userAgent string, ip string) (*models.TwoFactorChallenge, error)
+	VerifyTwoFactorLogin(ctx context.Context, login *models.TwoFactorLogin, ip string) (*models.UserResponse, error)
+	EnrollTwoFactor(ctx context.Context, enroll *models.TwoFactorEnroll) (*models.TwoFactorEnrollment, error)
+	ConfirmTwoFactor(ctx context.Context, code string) (*models.RecoveryCodes, error)
+	DisableTwoFactor(ctx context.Context, disable *models.TwoFactorDisable) error
+	RegenerateRecoveryCodes(ctx context.Context, code string) (*models.RecoveryCodes, error)
+	UnlockLogin(ctx context.Context, userID uuid.UUID) error
+	ListUsers(ctx context.Context, query *models.UsersQuery) (*models.UsersList, error)
+	ChangeRole(ctx context.Context, userID uuid.UUID, role models.Role) (*models.AdminUser, error)
+	SuspendUser(ctx context.Context, userID uuid.UUID, reason string) (*models.AdminUser, error)
+	UnsuspendUser(ctx context.Context, userID uuid.UUID) (*models.AdminUser, error)
+	DeleteUser(ctx context.Context, userID uuid.UUID) error
 }
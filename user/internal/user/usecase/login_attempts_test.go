@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/middlewares"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user"
+	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
+	sessionService "github.com/AleksK1NG/hotels-mocroservices/user/proto/session"
+)
+
+// memoryLoginAttemptsRepo in memory user.LoginAttemptsRepository without expiration
+type memoryLoginAttemptsRepo struct {
+	emailFailures map[string]int64
+	ipFailures    map[string]int64
+	locked        map[string]time.Duration
+	throttled     map[string]time.Duration
+}
+
+func newMemoryLoginAttemptsRepo() *memoryLoginAttemptsRepo {
+	return &memoryLoginAttemptsRepo{
+		emailFailures: make(map[string]int64),
+		ipFailures:    make(map[string]int64),
+		locked:        make(map[string]time.Duration),
+		throttled:     make(map[string]time.Duration),
+	}
+}
+
+func (r *memoryLoginAttemptsRepo) GetAttempts(ctx context.Context, email string, ip string) (*models.LoginAttempts, error) {
+	return &models.LoginAttempts{
+		EmailFailures: r.emailFailures[email],
+		IPFailures:    r.ipFailures[ip],
+		LockedFor:     r.locked[email],
+		ThrottledFor:  r.throttled[email],
+	}, nil
+}
+
+func (r *memoryLoginAttemptsRepo) RegisterFailure(ctx context.Context, email string, ip string) (*models.LoginAttempts, error) {
+	r.emailFailures[email]++
+	r.ipFailures[ip]++
+	return r.GetAttempts(ctx, email, ip)
+}
+
+func (r *memoryLoginAttemptsRepo) Lock(ctx context.Context, email string) error {
+	r.locked[email] = testLoginProtection.LockoutDuration * time.Second
+	return nil
+}
+
+func (r *memoryLoginAttemptsRepo) Throttle(ctx context.Context, email string, delay time.Duration) error {
+	r.throttled[email] = delay
+	return nil
+}
+
+func (r *memoryLoginAttemptsRepo) Reset(ctx context.Context, email string) error {
+	delete(r.emailFailures, email)
+	delete(r.locked, email)
+	delete(r.throttled, email)
+	return nil
+}
+
+var testLoginProtection = config.LoginProtection{
+	Window:           900,
+	MaxEmailFailures: 6,
+	MaxIPFailures:    20,
+	DelayAfter:       2,
+	BaseDelay:        1,
+	MaxDelay:         4,
+	LockoutDuration:  600,
+}
+
+func newLoginAttemptsUseCase() (*userUseCase, *memoryLoginAttemptsRepo) {
+	repo := newMemoryLoginAttemptsRepo()
+	return &userUseCase{
+		cfg:               &config.Config{LoginProtection: testLoginProtection},
+		loginAttemptsRepo: repo,
+		log:               newTestLogger(),
+	}, repo
+}
+
+func TestLoginDelay(t *testing.T) {
+	tests := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{failures: 0, want: 0},
+		{failures: 1, want: 0},
+		{failures: 2, want: time.Second},
+		{failures: 3, want: 2 * time.Second},
+		{failures: 4, want: 4 * time.Second},
+		{failures: 5, want: 4 * time.Second},
+		{failures: 100, want: 4 * time.Second},
+	}
+
+	uc, _ := newLoginAttemptsUseCase()
+	for _, tt := range tests {
+		if got := uc.loginDelay(tt.failures); got != tt.want {
+			t.Errorf("loginDelay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestLoginThrottlingAndLockout(t *testing.T) {
+	const (
+		email = "user@example.com"
+		ip    = "203.0.113.10"
+	)
+
+	tests := []struct {
+		name           string
+		emailFailures  int
+		otherIPs       bool
+		ipFailures     int
+		wantBlocked    bool
+		wantRetryAfter time.Duration
+	}{
+		{name: "no failures", wantBlocked: false},
+		{name: "failures below delay threshold", emailFailures: 1, wantBlocked: false},
+		{name: "throttled after delay threshold", emailFailures: 2, wantBlocked: true, wantRetryAfter: time.Second},
+		{name: "throttle doubles", emailFailures: 3, wantBlocked: true, wantRetryAfter: 2 * time.Second},
+		{name: "throttle capped by max delay", emailFailures: 5, wantBlocked: true, wantRetryAfter: 4 * time.Second},
+		{name: "email locked at max failures", emailFailures: 6, wantBlocked: true, wantRetryAfter: 600 * time.Second},
+		{name: "lockout from other ips", emailFailures: 6, otherIPs: true, wantBlocked: true, wantRetryAfter: 600 * time.Second},
+		{name: "ip blocked across emails", ipFailures: 20, wantBlocked: true, wantRetryAfter: 900 * time.Second},
+		{name: "ip below limit", ipFailures: 19, wantBlocked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			uc, _ := newLoginAttemptsUseCase()
+
+			failureIP := ip
+			if tt.otherIPs {
+				failureIP = "198.51.100.1"
+			}
+			for i := 0; i < tt.emailFailures; i++ {
+				uc.loginFailed(ctx, email, failureIP)
+			}
+			for i := 0; i < tt.ipFailures; i++ {
+				uc.loginFailed(ctx, "other@example.com", ip)
+			}
+
+			err := uc.checkLoginAttempts(ctx, email, ip)
+			if !tt.wantBlocked {
+				if err != nil {
+					t.Fatalf("checkLoginAttempts error = %v, want nil", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, httpErrors.TooManyLoginAttempts) {
+				t.Fatalf("checkLoginAttempts error = %v, want %v", err, httpErrors.TooManyLoginAttempts)
+			}
+			var retryErr *httpErrors.RetryAfterError
+			if !errors.As(err, &retryErr) {
+				t.Fatalf("checkLoginAttempts error = %T, want *RetryAfterError", err)
+			}
+			if retryErr.RetryAfter != tt.wantRetryAfter {
+				t.Errorf("RetryAfter = %v, want %v", retryErr.RetryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestLoginSucceededResetsEmailOnly(t *testing.T) {
+	const (
+		email = "user@example.com"
+		ip    = "203.0.113.10"
+	)
+	ctx := context.Background()
+	uc, repo := newLoginAttemptsUseCase()
+
+	for i := 0; i < int(testLoginProtection.MaxEmailFailures); i++ {
+		uc.loginFailed(ctx, email, ip)
+	}
+	uc.loginSucceeded(ctx, email)
+
+	if err := uc.checkLoginAttempts(ctx, email, ip); err != nil {
+		t.Errorf("checkLoginAttempts after reset error = %v, want nil", err)
+	}
+	if got := repo.ipFailures[ip]; got != testLoginProtection.MaxEmailFailures {
+		t.Errorf("ip failures after reset = %d, want %d", got, testLoginProtection.MaxEmailFailures)
+	}
+}
+
+// passwordRepo user with password, other user.PGRepository methods are not used by tests
+type passwordRepo struct {
+	user.PGRepository
+	user *models.User
+}
+
+func (r *passwordRepo) GetByIDWithPassword(ctx context.Context, userID uuid.UUID) (*models.User, error) {
+	return r.user, nil
+}
+
+func (r *passwordRepo) GetByID(ctx context.Context, userID uuid.UUID) (*models.UserResponse, error) {
+	return &models.UserResponse{UserID: r.user.UserID, Email: r.user.Email}, nil
+}
+
+func (r *passwordRepo) UpdatePassword(ctx context.Context, userID uuid.UUID, password string) error {
+	r.user.Password = password
+	return nil
+}
+
+// resetTokenRepo every token belongs to userID
+type resetTokenRepo struct {
+	user.TokenRepository
+	userID uuid.UUID
+}
+
+func (r *resetTokenRepo) ConsumeToken(ctx context.Context, token string) (uuid.UUID, error) {
+	return r.userID, nil
+}
+
+type sessClient struct {
+	sessionService.AuthorizationServiceClient
+}
+
+func (c *sessClient) RevokeAllUserSessions(ctx context.Context, in *sessionService.RevokeAllUserSessionsRequest, opts ...grpc.CallOption) (*sessionService.RevokeAllUserSessionsResponse, error) {
+	return &sessionService.RevokeAllUserSessionsResponse{}, nil
+}
+
+type userCacheRepo struct {
+	user.RedisRepository
+}
+
+func (r *userCacheRepo) DeleteUser(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+func TestReauthenticateCountsFailures(t *testing.T) {
+	const (
+		email    = "user@example.com"
+		ip       = "203.0.113.10"
+		password = "current password"
+	)
+
+	userWithPassword := &models.User{UserID: uuid.NewV4(), Email: email, Password: password}
+	if err := userWithPassword.PreparePassword(); err != nil {
+		t.Fatalf("PreparePassword: %v", err)
+	}
+
+	uc, _ := newLoginAttemptsUseCase()
+	uc.userPGRepo = &passwordRepo{user: userWithPassword}
+
+	ctx := context.WithValue(context.Background(), middlewares.RequestCtxUser{}, &models.UserResponse{UserID: userWithPassword.UserID, Email: email})
+	ctx = context.WithValue(ctx, middlewares.RequestCtxSession{}, &models.Session{UserID: userWithPassword.UserID, IP: ip})
+
+	if _, _, err := uc.reauthenticate(ctx, "wrong password"); err != httpErrors.WrongCredentials {
+		t.Fatalf("reauthenticate error = %v, want %v", err, httpErrors.WrongCredentials)
+	}
+	if _, _, err := uc.reauthenticate(ctx, password); err != nil {
+		t.Fatalf("reauthenticate error = %v, want nil", err)
+	}
+
+	for i := 1; i < int(testLoginProtection.MaxEmailFailures); i++ {
+		_, _, _ = uc.reauthenticate(ctx, "wrong password")
+	}
+	if _, _, err := uc.reauthenticate(ctx, password); !errors.Is(err, httpErrors.TooManyLoginAttempts) {
+		t.Errorf("reauthenticate after lockout error = %v, want %v", err, httpErrors.TooManyLoginAttempts)
+	}
+}
+
+func TestResetPasswordResetsLoginAttempts(t *testing.T) {
+	const (
+		email = "user@example.com"
+		ip    = "203.0.113.10"
+	)
+	ctx := context.Background()
+	userWithPassword := &models.User{UserID: uuid.NewV4(), Email: email}
+
+	uc, repo := newLoginAttemptsUseCase()
+	uc.userPGRepo = &passwordRepo{user: userWithPassword}
+	uc.resetTokenRepo = &resetTokenRepo{userID: userWithPassword.UserID}
+	uc.sessClient = &sessClient{}
+	uc.redisRepo = &userCacheRepo{}
+
+	for i := 0; i < int(testLoginProtection.MaxEmailFailures); i++ {
+		uc.loginFailed(ctx, email, ip)
+	}
+	if err := uc.checkLoginAttempts(ctx, email, ip); !errors.Is(err, httpErrors.TooManyLoginAttempts) {
+		t.Fatalf("checkLoginAttempts before reset error = %v, want %v", err, httpErrors.TooManyLoginAttempts)
+	}
+
+	if err := uc.ResetPassword(ctx, &models.ResetPassword{Token: "token", Password: "new password"}); err != nil {
+		t.Fatalf("ResetPassword error = %v, want nil", err)
+	}
+
+	if _, ok := repo.locked[email]; ok {
+		t.Errorf("email still locked after password reset")
+	}
+	if err := uc.checkLoginAttempts(ctx, email, ip); err != nil {
+		t.Errorf("checkLoginAttempts after reset error = %v, want nil", err)
+	}
+}
@@ -2,49 +2,125 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
 	"github.com/streadway/amqp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/middlewares"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user"
 	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user/delivery/rabbitmq"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/events"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/grpc_errors"
 	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
 	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/mailer"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/oidc"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/rbac"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/totp"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/utils"
+	eventsService "github.com/AleksK1NG/hotels-mocroservices/user/proto/events"
 	sessionService "github.com/AleksK1NG/hotels-mocroservices/user/proto/session"
 )
 
 const (
 	imagesExchange = "images"
 	resizeKey      = "resize_image_key"
-	userUUIDHeader = "user_uuid"
+
+	maxUsersBatchSize    = 500
+	usersStreamChunkSize = 100
+
+	tokenBytes           = 32
+	resetPasswordSubject = "Reset your password"
+	verifyEmailSubject   = "Confirm your email"
+	emailChangedSubject  = "Your email was changed"
+
+	maxNameLength = 60
+
+	recoveryCodeBytes = 5
+
+	// bcrypt hash of random password with default cost, used for unknown emails on login
+	dummyPasswordHash = "$2a$10$oXkfNpFuPAg6JaPERS7pLO/qRwE2fBpJQFe9xI88xT/XvmqlhctSm"
+
+	loginResultSuccess = "success"
+	loginResultFailure = "failure"
+	loginResultBlocked = "blocked"
+)
+
+var (
+	loginAttemptsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_login_attempts_total",
+		Help: "The total number of login attempts by result",
+	}, []string{"result"})
+	loginLockoutsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_login_lockouts_total",
+		Help: "The total number of emails locked after too many failed logins",
+	})
 )
 
 type userUseCase struct {
-	userPGRepo    user.PGRepository
-	sessClient    sessionService.AuthorizationServiceClient
-	redisRepo     user.RedisRepository
-	log           logger.Logger
-	amqpPublisher rabbitmq.Publisher
+	cfg               *config.Config
+	userPGRepo        user.PGRepository
+	sessClient        sessionService.AuthorizationServiceClient
+	redisRepo         user.RedisRepository
+	resetTokenRepo    user.TokenRepository
+	verifyTokenRepo   user.TokenRepository
+	oidcStateRepo     user.OIDCStateRepository
+	loginAttemptsRepo user.LoginAttemptsRepository
+	oidcProviders     map[string]*oidc.Provider
+	totpCipher        *totp.Cipher
+	log               logger.Logger
+	amqpPublisher     rabbitmq.Publisher
+	mailer            mailer.Mailer
 }
 
 func NewUserUseCase(
+	cfg *config.Config,
 	userPGRepo user.PGRepository,
 	sessClient sessionService.AuthorizationServiceClient,
 	redisRepo user.RedisRepository,
+	resetTokenRepo user.TokenRepository,
+	verifyTokenRepo user.TokenRepository,
+	oidcStateRepo user.OIDCStateRepository,
+	loginAttemptsRepo user.LoginAttemptsRepository,
+	oidcProviders map[string]*oidc.Provider,
+	totpCipher *totp.Cipher,
 	log logger.Logger,
 	amqpPublisher rabbitmq.Publisher,
+	mailer mailer.Mailer,
 ) *userUseCase {
 	return &userUseCase{
-		userPGRepo:    userPGRepo,
-		sessClient:    sessClient,
-		redisRepo:     redisRepo,
-		log:           log,
-		amqpPublisher: amqpPublisher,
+		cfg:               cfg,
+		userPGRepo:        userPGRepo,
+		sessClient:        sessClient,
+		redisRepo:         redisRepo,
+		resetTokenRepo:    resetTokenRepo,
+		verifyTokenRepo:   verifyTokenRepo,
+		oidcStateRepo:     oidcStateRepo,
+		loginAttemptsRepo: loginAttemptsRepo,
+		oidcProviders:     oidcProviders,
+		totpCipher:        totpCipher,
+		log:               log,
+		amqpPublisher:     amqpPublisher,
+		mailer:            mailer,
 	}
 }
 
@@ -85,20 +161,55 @@ func (u *userUseCase) Register(ctx context.Context, user *models.User) (*models.
 		return nil, errors.Wrap(err, "userPGRepo.Create")
 	}
 
-	return created, err
+	if err := u.sendVerificationEmail(ctx, created); err != nil {
+		u.log.Errorf("sendVerificationEmail: %v", err)
+	}
+
+	return created, nil
 }
 
-func (u *userUseCase) Login(ctx context.Context, login models.Login) (*models.User, error) {
+// Login check credentials, unknown email and wrong password fail the same way and both count
+// as failed attempts, email is locked after too many failures and ip is blocked after too many failures
+func (u *userUseCase) Login(ctx context.Context, login models.Login, ip string) (*models.User, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.Login")
 	defer span.Finish()
 
-	userByEmail, err := u.userPGRepo.GetByEmail(ctx, login.Email)
+	email := strings.ToLower(strings.TrimSpace(login.Email))
+
+	if err := u.checkLoginAttempts(ctx, email, ip); err != nil {
+		return nil, err
+	}
+
+	userByEmail, err := u.userPGRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return nil, errors.Wrap(err, "userPGRepo.GetByEmail")
+		if errors.Cause(err) != pgx.ErrNoRows {
+			return nil, errors.Wrap(err, "userPGRepo.GetByEmail")
+		}
+		// compare with dummy hash anyway, so response time doesn't tell that email is unknown
+		dummyUser := &models.User{Password: dummyPasswordHash}
+		_ = dummyUser.ComparePasswords(login.Password)
+		u.loginFailed(ctx, email, ip)
+		return nil, httpErrors.WrongCredentials
 	}
 
 	if err := userByEmail.ComparePasswords(login.Password); err != nil {
-		return nil, errors.Wrap(err, "userUseCase.ComparePasswords")
+		u.loginFailed(ctx, email, ip)
+		return nil, httpErrors.WrongCredentials
+	}
+
+	// with two-factor enabled failed attempts are kept until the second factor passes,
+	// so right password doesn't give unlimited code guesses
+	if userByEmail.TwoFactorEnabledAt == nil {
+		u.loginSucceeded(ctx, email)
+	}
+
+	if userByEmail.IsSuspended() {
+		u.log.Warnw("suspended user login",
+			"event", "login_suspended",
+			"user_id", userByEmail.UserID.String(),
+			"ip", ip,
+		)
+		return nil, httpErrors.AccountSuspended
 	}
 
 	userByEmail.SanitizePassword()
@@ -106,16 +217,345 @@ func (u *userUseCase) Login(ctx context.Context, login models.Login) (*models.Us
 	return userByEmail, nil
 }
 
-func (u *userUseCase) CreateSession(ctx context.Context, userID uuid.UUID) (string, error) {
+// checkLoginAttempts fail with TooManyLoginAttempts and time to retry when email is locked or throttled
+// or ip made too many failed attempts, requests are rejected instead of waiting for the delay
+func (u *userUseCase) checkLoginAttempts(ctx context.Context, email string, ip string) error {
+	attempts, err := u.loginAttemptsRepo.GetAttempts(ctx, email, ip)
+	if err != nil {
+		return errors.Wrap(err, "loginAttemptsRepo.GetAttempts")
+	}
+
+	var retryAfter time.Duration
+	switch {
+	case attempts.LockedFor > 0:
+		retryAfter = attempts.LockedFor
+	case attempts.IPFailures >= u.cfg.LoginProtection.MaxIPFailures:
+		retryAfter = u.cfg.LoginProtection.Window * time.Second
+	case attempts.ThrottledFor > 0:
+		retryAfter = attempts.ThrottledFor
+	default:
+		return nil
+	}
+
+	loginAttemptsTotal.WithLabelValues(loginResultBlocked).Inc()
+	u.log.Warnw("login blocked",
+		"event", "login_blocked",
+		"email", email,
+		"ip", ip,
+		"locked_for", attempts.LockedFor.String(),
+		"throttled_for", attempts.ThrottledFor.String(),
+		"ip_failures", attempts.IPFailures,
+	)
+	return httpErrors.NewRetryAfterError(httpErrors.TooManyLoginAttempts, retryAfter)
+}
+
+// loginSucceeded drop failed attempts of email after all login factors passed
+func (u *userUseCase) loginSucceeded(ctx context.Context, email string) {
+	if err := u.loginAttemptsRepo.Reset(ctx, email); err != nil {
+		u.log.Errorf("loginAttemptsRepo.Reset: %v", err)
+	}
+	loginAttemptsTotal.WithLabelValues(loginResultSuccess).Inc()
+}
+
+// loginFailed count failed password or second factor attempt and lock email when limit is reached
+func (u *userUseCase) loginFailed(ctx context.Context, email string, ip string) {
+	loginAttemptsTotal.WithLabelValues(loginResultFailure).Inc()
+
+	attempts, err := u.loginAttemptsRepo.RegisterFailure(ctx, email, ip)
+	if err != nil {
+		u.log.Errorf("loginAttemptsRepo.RegisterFailure: %v", err)
+		return
+	}
+
+	u.log.Warnw("login failed",
+		"event", "login_failed",
+		"email", email,
+		"ip", ip,
+		"email_failures", attempts.EmailFailures,
+		"ip_failures", attempts.IPFailures,
+	)
+
+	if attempts.EmailFailures >= u.cfg.LoginProtection.MaxEmailFailures {
+		if err := u.loginAttemptsRepo.Lock(ctx, email); err != nil {
+			u.log.Errorf("loginAttemptsRepo.Lock: %v", err)
+			return
+		}
+		loginLockoutsTotal.Inc()
+		u.log.Warnw("login locked",
+			"event", "login_locked",
+			"email", email,
+			"ip", ip,
+			"lockout", (u.cfg.LoginProtection.LockoutDuration * time.Second).String(),
+		)
+		return
+	}
+
+	if delay := u.loginDelay(attempts.EmailFailures); delay > 0 {
+		if err := u.loginAttemptsRepo.Throttle(ctx, email, delay); err != nil {
+			u.log.Errorf("loginAttemptsRepo.Throttle: %v", err)
+		}
+	}
+}
+
+// loginDelay progressive delay before next login attempt of email, doubles with each failure after DelayAfter
+func (u *userUseCase) loginDelay(failures int64) time.Duration {
+	cfg := u.cfg.LoginProtection
+	if failures < cfg.DelayAfter {
+		return 0
+	}
+
+	maxDelay := cfg.MaxDelay * time.Second
+	delay := cfg.BaseDelay * time.Second
+	for i := cfg.DelayAfter; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay
+}
+
+// UnlockLogin requires user:manage permission, drop failed login counter and lockout of user email
+func (u *userUseCase) UnlockLogin(ctx context.Context, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.UnlockLogin")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+	if !ctxUser.HasPermission(rbac.UserManage) {
+		return httpErrors.PermissionDenied
+	}
+
+	userResponse, err := u.userPGRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "userPGRepo.GetByID")
+	}
+
+	if err := u.loginAttemptsRepo.Reset(ctx, userResponse.Email); err != nil {
+		return errors.Wrap(err, "loginAttemptsRepo.Reset")
+	}
+
+	u.log.Warnw("login unlocked",
+		"event", "login_unlocked",
+		"email", userResponse.Email,
+		"user_id", userID.String(),
+		"admin_id", ctxUser.UserID.String(),
+	)
+
+	return nil
+}
+
+// ListUsers requires user:manage permission, users page filtered by search, role and account status
+func (u *userUseCase) ListUsers(ctx context.Context, query *models.UsersQuery) (*models.UsersList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ListUsers")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+	if !ctxUser.HasPermission(rbac.UserManage) {
+		return nil, httpErrors.PermissionDenied
+	}
+
+	usersList, err := u.userPGRepo.ListUsers(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "userPGRepo.ListUsers")
+	}
+
+	return usersList, nil
+}
+
+// ChangeRole requires user:manage permission, admin can't change own role
+func (u *userUseCase) ChangeRole(ctx context.Context, userID uuid.UUID, role models.Role) (*models.AdminUser, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ChangeRole")
+	defer span.Finish()
+
+	ctxUser, err := u.managingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := u.userPGRepo.UpdateRole(ctx, userID, role)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, httpErrors.NotFound
+		}
+		return nil, errors.Wrap(err, "userPGRepo.UpdateRole")
+	}
+
+	u.userChanged(ctx, userID)
+	u.log.Warnw("user role changed",
+		"event", "user_role_changed",
+		"user_id", userID.String(),
+		"role", role.ToString(),
+		"admin_id", ctxUser.UserID.String(),
+	)
+
+	return updated, nil
+}
+
+// SuspendUser requires user:manage permission, suspended user can't log in and all user sessions are revoked
+func (u *userUseCase) SuspendUser(ctx context.Context, userID uuid.UUID, reason string) (*models.AdminUser, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.SuspendUser")
+	defer span.Finish()
+
+	ctxUser, err := u.managingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	suspended, err := u.userPGRepo.Suspend(ctx, userID, reason)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, httpErrors.NotFound
+		}
+		return nil, errors.Wrap(err, "userPGRepo.Suspend")
+	}
+
+	u.userChanged(ctx, userID)
+
+	revoked, err := u.revokeOtherSessions(ctx, userID, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "revokeOtherSessions")
+	}
+
+	u.log.Warnw("user suspended",
+		"event", "user_suspended",
+		"user_id", userID.String(),
+		"reason", reason,
+		"revoked_sessions", revoked,
+		"admin_id", ctxUser.UserID.String(),
+	)
+
+	return suspended, nil
+}
+
+// UnsuspendUser requires user:manage permission, user can log in again
+func (u *userUseCase) UnsuspendUser(ctx context.Context, userID uuid.UUID) (*models.AdminUser, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.UnsuspendUser")
+	defer span.Finish()
+
+	ctxUser, err := u.managingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unsuspended, err := u.userPGRepo.Unsuspend(ctx, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, httpErrors.NotFound
+		}
+		return nil, errors.Wrap(err, "userPGRepo.Unsuspend")
+	}
+
+	u.userChanged(ctx, userID)
+	u.log.Warnw("user unsuspended",
+		"event", "user_unsuspended",
+		"user_id", userID.String(),
+		"admin_id", ctxUser.UserID.String(),
+	)
+
+	return unsuspended, nil
+}
+
+// DeleteUser requires user:manage permission, personal data is erased and all user sessions are revoked,
+// the user row is kept anonymized so hotels and comments of the user stay consistent
+func (u *userUseCase) DeleteUser(ctx context.Context, userID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.DeleteUser")
+	defer span.Finish()
+
+	ctxUser, err := u.managingUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	userResponse, err := u.userPGRepo.GetByID(ctx, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return httpErrors.NotFound
+		}
+		return errors.Wrap(err, "userPGRepo.GetByID")
+	}
+
+	if err := u.userPGRepo.Anonymize(ctx, userID); err != nil {
+		if isNotFound(err) {
+			return httpErrors.NotFound
+		}
+		return errors.Wrap(err, "userPGRepo.Anonymize")
+	}
+
+	u.userChanged(ctx, userID)
+
+	if _, err := u.revokeOtherSessions(ctx, userID, ""); err != nil {
+		return errors.Wrap(err, "revokeOtherSessions")
+	}
+	if err := u.loginAttemptsRepo.Reset(ctx, userResponse.Email); err != nil {
+		u.log.Errorf("loginAttemptsRepo.Reset: %v", err)
+	}
+
+	u.log.Warnw("user deleted",
+		"event", "user_deleted",
+		"user_id", userID.String(),
+		"admin_id", ctxUser.UserID.String(),
+	)
+
+	return nil
+}
+
+// managingUser current user allowed to manage account of user with given id
+func (u *userUseCase) managingUser(ctx context.Context, userID uuid.UUID) (*models.UserResponse, error) {
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+	if !ctxUser.HasPermission(rbac.UserManage) {
+		return nil, httpErrors.PermissionDenied
+	}
+	if uuid.Equal(ctxUser.UserID, userID) {
+		return nil, httpErrors.CannotManageOwnUser
+	}
+	return ctxUser, nil
+}
+
+// userChanged drop cached user and notify other services caching user data
+func (u *userUseCase) userChanged(ctx context.Context, userID uuid.UUID) {
+	if err := u.redisRepo.DeleteUser(ctx, userID); err != nil {
+		u.log.Errorf("redisRepo.DeleteUser: %v", err)
+	}
+	if err := u.publishUserUpdated(ctx, userID); err != nil {
+		u.log.Errorf("publishUserUpdated: %v", err)
+	}
+}
+
+// isNotFound missing or deleted user
+func isNotFound(err error) bool {
+	cause := errors.Cause(err)
+	return cause == pgx.ErrNoRows || cause == sql.ErrNoRows
+}
+
+func (u *userUseCase) CreateSession(ctx context.Context, userID uuid.UUID, userAgent string, ip string) (*models.Session, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.CreateSession")
 	defer span.Finish()
 
-	session, err := u.sessClient.CreateSession(ctx, &sessionService.CreateSessionRequest{UserID: userID.String()})
+	session, err := u.sessClient.CreateSession(ctx, &sessionService.CreateSessionRequest{
+		UserID:    userID.String(),
+		UserAgent: userAgent,
+		IP:        ip,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sessClient.CreateSession")
+	}
+
+	sess, err := (&models.Session{}).FromProto(session.GetSession())
 	if err != nil {
-		return "", errors.Wrap(err, "sessClient.CreateSession")
+		return nil, errors.Wrap(err, "sess.FromProto")
 	}
 
-	return session.GetSession().GetSessionID(), err
+	return sess, nil
 }
 
 func (u *userUseCase) DeleteSession(ctx context.Context, sessionID string) error {
@@ -130,6 +570,66 @@ func (u *userUseCase) DeleteSession(ctx context.Context, sessionID string) error
 	return nil
 }
 
+// ListSessions active sessions of current user, current session is marked
+func (u *userUseCase) ListSessions(ctx context.Context) ([]*models.Session, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ListSessions")
+	defer span.Finish()
+
+	ctxSession, ok := ctx.Value(middlewares.RequestCtxSession{}).(*models.Session)
+	if !ok {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value session")
+	}
+
+	res, err := u.sessClient.ListUserSessions(ctx, &sessionService.ListUserSessionsRequest{UserID: ctxSession.UserID.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "sessClient.ListUserSessions")
+	}
+
+	sessions := make([]*models.Session, 0, len(res.GetSessions()))
+	for _, s := range res.GetSessions() {
+		sess, err := (&models.Session{}).FromProto(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "sess.FromProto")
+		}
+		sess.Current = sess.SessionID == ctxSession.SessionID
+		sessions = append(sessions, sess)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revoke one of current user sessions by its device id
+func (u *userUseCase) RevokeSession(ctx context.Context, deviceID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.RevokeSession")
+	defer span.Finish()
+
+	sessions, err := u.ListSessions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sess := range sessions {
+		if sess.DeviceID == deviceID {
+			return u.DeleteSession(ctx, sess.SessionID)
+		}
+	}
+
+	return httpErrors.NotFound
+}
+
+// RevokeOtherSessions revoke all current user sessions except the current one
+func (u *userUseCase) RevokeOtherSessions(ctx context.Context) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.RevokeOtherSessions")
+	defer span.Finish()
+
+	ctxSession, ok := ctx.Value(middlewares.RequestCtxSession{}).(*models.Session)
+	if !ok {
+		return 0, errors.Wrap(httpErrors.Unauthorized, "ctx.Value session")
+	}
+
+	return u.revokeOtherSessions(ctx, ctxSession.UserID, ctxSession.SessionID)
+}
+
 func (u *userUseCase) GetSessionByID(ctx context.Context, sessionID string) (*models.Session, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.GetSessionByID")
 	defer span.Finish()
@@ -144,6 +644,7 @@ func (u *userUseCase) GetSessionByID(ctx context.Context, sessionID string) (*mo
 	if err != nil {
 		return nil, errors.Wrap(err, "sess.FromProto")
 	}
+	sess.Renewed = sessionByID.GetRenewed()
 
 	return sess, nil
 }
@@ -172,8 +673,9 @@ func (u *userUseCase) Update(ctx context.Context, user *models.UserUpdate) (*mod
 		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
 	}
 
-	if ctxUser.UserID != user.UserID || *ctxUser.Role != models.RoleAdmin {
-		return nil, errors.Wrap(httpErrors.WrongCredentials, "user is not owner or admin")
+	if ctxUser.UserID != user.UserID && !ctxUser.HasPermission(rbac.UserUpdateAny) {
+		u.log.Warnf("user: %s is not owner of account: %s", ctxUser.UserID.String(), user.UserID.String())
+		return nil, httpErrors.PermissionDenied
 	}
 
 	userResponse, err := u.userPGRepo.Update(ctx, user)
@@ -185,6 +687,10 @@ func (u *userUseCase) Update(ctx context.Context, user *models.UserUpdate) (*mod
 		u.log.Errorf("redisRepo.SaveUser: %v", err)
 	}
 
+	if err := u.publishUserUpdated(ctx, userResponse.UserID); err != nil {
+		u.log.Errorf("publishUserUpdated: %v", err)
+	}
+
 	return userResponse, nil
 }
 
@@ -192,26 +698,28 @@ func (u *userUseCase) UpdateUploadedAvatar(ctx context.Context, delivery amqp.De
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.UpdateUploadedAvatar")
 	defer span.Finish()
 
-	var img models.Image
-	if err := json.Unmarshal(delivery.Body, &img); err != nil {
-		return errors.Wrap(err, "UpdateUploadedAvatar.json.Unmarshal")
+	env, err := events.Unmarshal(delivery.Body, events.ImageCreatedType)
+	if err != nil {
+		return errors.Wrap(err, "UpdateUploadedAvatar.events.Unmarshal")
 	}
+	img := env.GetImageCreated()
 
-	userUUID, ok := delivery.Headers[userUUIDHeader].(string)
-	if !ok {
-		return errors.Wrap(httpErrors.InvalidUUID, "delivery.Headers")
+	uid, err := uuid.FromString(img.GetUserID())
+	if err != nil {
+		return errors.Wrap(err, "uuid.FromString")
 	}
 
-	uid, err := uuid.FromString(userUUID)
+	imageID, err := uuid.FromString(img.GetImageID())
 	if err != nil {
 		return errors.Wrap(err, "uuid.FromString")
 	}
 
 	created, err := u.userPGRepo.UpdateAvatar(ctx, models.UploadedImageMsg{
-		ImageID:    img.ImageID,
+		ImageID:    imageID,
 		UserID:     uid,
-		ImageURL:   img.ImageURL,
-		IsUploaded: img.IsUploaded,
+		ImageURL:   img.GetImageURL(),
+		IsUploaded: img.GetIsUploaded(),
+		CreatedAt:  img.GetCreatedAt().AsTime(),
 	})
 	if err != nil {
 		return err
@@ -219,32 +727,966 @@ func (u *userUseCase) UpdateUploadedAvatar(ctx context.Context, delivery amqp.De
 
 	u.log.Infof("UpdateUploadedAvatar: %s", created.Avatar)
 
+	if err := u.publishUserUpdated(ctx, uid); err != nil {
+		u.log.Errorf("publishUserUpdated: %v", err)
+	}
+
 	return nil
 }
 
+// publishUserUpdated notify other services caching user data that user profile changed
+func (u *userUseCase) publishUserUpdated(ctx context.Context, userID uuid.UUID) error {
+	msgBytes, err := events.Marshal(ctx, &eventsService.Envelope{
+		Payload: &eventsService.Envelope_UserUpdated{UserUpdated: &eventsService.UserUpdated{
+			UserID: userID.String(),
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "events.Marshal")
+	}
+
+	return u.amqpPublisher.Publish(ctx, rabbitmq.UserExchange, rabbitmq.UserUpdatedKey, events.ContentType, nil, msgBytes)
+}
+
 func (u *userUseCase) UpdateAvatar(ctx context.Context, data *models.UpdateAvatarMsg) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.UpdateAvatar")
 	defer span.Finish()
 
-	headers := make(amqp.Table, 1)
-	headers[userUUIDHeader] = data.UserID.String()
+	msgBytes, err := events.Marshal(ctx, &eventsService.Envelope{
+		Payload: &eventsService.Envelope_ResizeImage{ResizeImage: &eventsService.ResizeImage{
+			UserID:      data.UserID.String(),
+			ContentType: data.ContentType,
+			Data:        data.Body,
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdateAvatar.events.Marshal")
+	}
+
 	if err := u.amqpPublisher.Publish(
 		ctx,
 		imagesExchange,
 		resizeKey,
-		data.ContentType,
-		headers,
-		data.Body,
+		events.ContentType,
+		nil,
+		msgBytes,
 	); err != nil {
-		return errors.Wrap(err, "UpdateUploadedAvatar.Publish")
+		return errors.Wrap(err, "UpdateAvatar.Publish")
 	}
 
-	u.log.Infof("Publish UpdateAvatar %-v", headers)
+	u.log.Infof("Publish UpdateAvatar %s", data.UserID)
 	return nil
 }
 
-func (u *userUseCase) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*models.UserResponse, error) {
+func (u *userUseCase) GetUsersByIDs(ctx context.Context, userIDs []string) (*models.UsersByIDs, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.GetUsersByIDs")
 	defer span.Finish()
-	return u.userPGRepo.GetUsersByIDs(ctx, userIDs)
+
+	userIDs = utils.UniqueStrings(userIDs)
+	if len(userIDs) > maxUsersBatchSize {
+		return nil, grpc_errors.ErrTooManyUserIDs
+	}
+
+	return u.getUsersByIDs(ctx, userIDs)
+}
+
+// StreamUsersByIDs send users of large sets by chunks in request order
+func (u *userUseCase) StreamUsersByIDs(ctx context.Context, userIDs []string, send func(users *models.UsersByIDs) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.StreamUsersByIDs")
+	defer span.Finish()
+
+	userIDs = utils.UniqueStrings(userIDs)
+	for start := 0; start < len(userIDs); start += usersStreamChunkSize {
+		end := start + usersStreamChunkSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+
+		users, err := u.getUsersByIDs(ctx, userIDs[start:end])
+		if err != nil {
+			return err
+		}
+		if err := send(users); err != nil {
+			return errors.Wrap(err, "send")
+		}
+	}
+
+	return nil
+}
+
+// getUsersByIDs returns users in request order, invalid and not found ids are reported as missing
+func (u *userUseCase) getUsersByIDs(ctx context.Context, userIDs []string) (*models.UsersByIDs, error) {
+	uuids := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		uid, err := uuid.FromString(id)
+		if err != nil {
+			continue
+		}
+		uuids = append(uuids, uid)
+	}
+
+	found, err := u.userPGRepo.GetUsersByIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	foundMap := make(map[string]*models.UserResponse, len(found))
+	for _, user := range found {
+		foundMap[user.UserID.String()] = user
+	}
+
+	result := &models.UsersByIDs{Users: make([]*models.UserResponse, 0, len(found)), MissingIDs: make([]string, 0)}
+	for _, id := range userIDs {
+		if user, ok := foundMap[id]; ok {
+			result.Users = append(result.Users, user)
+			continue
+		}
+		result.MissingIDs = append(result.MissingIDs, id)
+	}
+
+	return result, nil
+}
+
+// ForgotPassword send single use reset token to user email, unknown emails are silently ignored
+func (u *userUseCase) ForgotPassword(ctx context.Context, email string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ForgotPassword")
+	defer span.Finish()
+
+	userByEmail, err := u.userPGRepo.GetByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		u.log.Infof("ForgotPassword userPGRepo.GetByEmail: %v", err)
+		return nil
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return errors.Wrap(err, "generateToken")
+	}
+
+	if err := u.resetTokenRepo.SaveToken(ctx, token, userByEmail.UserID); err != nil {
+		return errors.Wrap(err, "resetTokenRepo.SaveResetToken")
+	}
+
+	body := fmt.Sprintf(
+		"Hi %s,\n\nTo reset your password open the link below, it is valid for %d minutes:\n%s?token=%s\n\nIf you did not request a password reset, ignore this email.\n",
+		userByEmail.FirstName,
+		int(u.cfg.PasswordReset.TokenTTL/60),
+		u.cfg.PasswordReset.ResetURL,
+		token,
+	)
+	if err := u.mailer.Send(ctx, userByEmail.Email, resetPasswordSubject, body); err != nil {
+		return errors.Wrap(err, "mailer.Send")
+	}
+
+	return nil
+}
+
+// ResetPassword set new password by reset token and revoke every user session
+func (u *userUseCase) ResetPassword(ctx context.Context, reset *models.ResetPassword) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ResetPassword")
+	defer span.Finish()
+
+	userID, err := u.resetTokenRepo.ConsumeToken(ctx, reset.Token)
+	if err != nil {
+		if errors.Cause(err) == redis.Nil {
+			return httpErrors.InvalidResetToken
+		}
+		return errors.Wrap(err, "resetTokenRepo.ConsumeToken")
+	}
+
+	newPassword := &models.User{Password: reset.Password}
+	if err := newPassword.PreparePassword(); err != nil {
+		return errors.Wrap(err, "PreparePassword")
+	}
+
+	if err := u.userPGRepo.UpdatePassword(ctx, userID, newPassword.Password); err != nil {
+		return errors.Wrap(err, "userPGRepo.UpdatePassword")
+	}
+
+	if _, err := u.sessClient.RevokeAllUserSessions(ctx, &sessionService.RevokeAllUserSessionsRequest{UserID: userID.String()}); err != nil {
+		return errors.Wrap(err, "sessClient.RevokeAllUserSessions")
+	}
+
+	// owner proved access to email, login throttle and lockout of the account are lifted
+	userResponse, err := u.userPGRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "userPGRepo.GetByID")
+	}
+	if err := u.loginAttemptsRepo.Reset(ctx, userResponse.Email); err != nil {
+		u.log.Errorf("loginAttemptsRepo.Reset: %v", err)
+	}
+
+	if err := u.redisRepo.DeleteUser(ctx, userID); err != nil {
+		u.log.Errorf("redisRepo.DeleteUser: %v", err)
+	}
+
+	return nil
+}
+
+// ChangePassword set new password after current password check, other user sessions are revoked
+func (u *userUseCase) ChangePassword(ctx context.Context, change *models.ChangePassword) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ChangePassword")
+	defer span.Finish()
+
+	ctxUser, ctxSession, err := u.reauthenticate(ctx, change.CurrentPassword)
+	if err != nil {
+		return err
+	}
+
+	newPassword := &models.User{Password: change.NewPassword}
+	if err := newPassword.PreparePassword(); err != nil {
+		return errors.Wrap(err, "PreparePassword")
+	}
+
+	if err := u.userPGRepo.UpdatePassword(ctx, ctxUser.UserID, newPassword.Password); err != nil {
+		return errors.Wrap(err, "userPGRepo.UpdatePassword")
+	}
+
+	if _, err := u.revokeOtherSessions(ctx, ctxUser.UserID, ctxSession.SessionID); err != nil {
+		return err
+	}
+
+	if err := u.redisRepo.DeleteUser(ctx, ctxUser.UserID); err != nil {
+		u.log.Errorf("redisRepo.DeleteUser: %v", err)
+	}
+
+	return nil
+}
+
+// ChangeEmail set new email after current password check, new email must be verified again
+func (u *userUseCase) ChangeEmail(ctx context.Context, change *models.ChangeEmail) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ChangeEmail")
+	defer span.Finish()
+
+	ctxUser, ctxSession, err := u.reauthenticate(ctx, change.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	oldEmail := ctxUser.Email
+	userResponse, err := u.userPGRepo.UpdateEmail(ctx, ctxUser.UserID, strings.ToLower(strings.TrimSpace(change.Email)))
+	if err != nil {
+		if strings.Contains(err.Error(), pgerrcode.UniqueViolation) {
+			return nil, httpErrors.ExistsEmailError
+		}
+		return nil, errors.Wrap(err, "userPGRepo.UpdateEmail")
+	}
+
+	if _, err := u.revokeOtherSessions(ctx, ctxUser.UserID, ctxSession.SessionID); err != nil {
+		return nil, err
+	}
+
+	if err := u.redisRepo.DeleteUser(ctx, ctxUser.UserID); err != nil {
+		u.log.Errorf("redisRepo.DeleteUser: %v", err)
+	}
+
+	if err := u.sendVerificationEmail(ctx, userResponse); err != nil {
+		u.log.Errorf("sendVerificationEmail: %v", err)
+	}
+
+	body := fmt.Sprintf("Hi %s,\n\nThe email of your account was changed to %s.\nIf you did not do it, reset your password.\n", userResponse.FirstName, userResponse.Email)
+	if err := u.mailer.Send(ctx, oldEmail, emailChangedSubject, body); err != nil {
+		u.log.Errorf("mailer.Send: %v", err)
+	}
+
+	return userResponse, nil
+}
+
+// reauthenticate check current password of session user, wrong passwords count against the same
+// email and ip limits as login, so session holder can't brute force the password
+func (u *userUseCase) reauthenticate(ctx context.Context, password string) (*models.UserResponse, *models.Session, error) {
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return nil, nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+	ctxSession, ok := ctx.Value(middlewares.RequestCtxSession{}).(*models.Session)
+	if !ok {
+		return nil, nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value session")
+	}
+
+	if err := u.checkLoginAttempts(ctx, ctxUser.Email, ctxSession.IP); err != nil {
+		return nil, nil, err
+	}
+
+	userWithPassword, err := u.userPGRepo.GetByIDWithPassword(ctx, ctxUser.UserID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "userPGRepo.GetByIDWithPassword")
+	}
+
+	if err := userWithPassword.ComparePasswords(password); err != nil {
+		u.loginFailed(ctx, ctxUser.Email, ctxSession.IP)
+		return nil, nil, httpErrors.WrongCredentials
+	}
+
+	return ctxUser, ctxSession, nil
+}
+
+func (u *userUseCase) revokeOtherSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) (int64, error) {
+	res, err := u.sessClient.RevokeAllUserSessions(ctx, &sessionService.RevokeAllUserSessionsRequest{
+		UserID:          userID.String(),
+		ExceptSessionID: currentSessionID,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "sessClient.RevokeAllUserSessions")
+	}
+	return res.GetRevoked(), nil
+}
+
+// ResendVerification send new verification token to current user email
+func (u *userUseCase) ResendVerification(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ResendVerification")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	if ctxUser.IsEmailVerified() {
+		return httpErrors.EmailAlreadyVerified
+	}
+
+	return u.sendVerificationEmail(ctx, ctxUser)
+}
+
+// ConfirmEmail mark user email as verified by token
+func (u *userUseCase) ConfirmEmail(ctx context.Context, token string) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ConfirmEmail")
+	defer span.Finish()
+
+	userID, err := u.verifyTokenRepo.ConsumeToken(ctx, token)
+	if err != nil {
+		if errors.Cause(err) == redis.Nil {
+			return nil, httpErrors.InvalidVerifyToken
+		}
+		return nil, errors.Wrap(err, "verifyTokenRepo.ConsumeToken")
+	}
+
+	userResponse, err := u.userPGRepo.VerifyEmail(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "userPGRepo.VerifyEmail")
+	}
+
+	if err := u.redisRepo.SaveUser(ctx, userResponse); err != nil {
+		u.log.Errorf("redisRepo.SaveUser: %v", err)
+	}
+
+	return userResponse, nil
+}
+
+func (u *userUseCase) sendVerificationEmail(ctx context.Context, user *models.UserResponse) error {
+	token, err := generateToken()
+	if err != nil {
+		return errors.Wrap(err, "generateToken")
+	}
+
+	if err := u.verifyTokenRepo.SaveToken(ctx, token, user.UserID); err != nil {
+		return errors.Wrap(err, "verifyTokenRepo.SaveToken")
+	}
+
+	body := fmt.Sprintf(
+		"Hi %s,\n\nPlease confirm your email by opening the link below, it is valid for %d hours:\n%s?token=%s\n",
+		user.FirstName,
+		int(u.cfg.EmailVerification.TokenTTL/3600),
+		u.cfg.EmailVerification.VerifyURL,
+		token,
+	)
+	if err := u.mailer.Send(ctx, user.Email, verifyEmailSubject, body); err != nil {
+		return errors.Wrap(err, "mailer.Send")
+	}
+
+	return nil
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CheckCSRFToken validate csrf token of session
+func (u *userUseCase) CheckCSRFToken(ctx context.Context, sessionID string, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.CheckCSRFToken")
+	defer span.Finish()
+
+	res, err := u.sessClient.CheckCsrfToken(
+		ctx,
+		&sessionService.CheckCsrfTokenRequest{CsrfTokenCheck: &sessionService.CsrfTokenCheck{SessionID: sessionID, Token: token}},
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "sessClient.CheckCsrfToken")
+	}
+
+	return res.GetCheckResult().GetResult(), nil
+}
+
+// IssueToken exchange credentials or refresh token for a new access and refresh token pair,
+// password grant of user with two-factor enabled returns challenge to finish with two_factor grant
+func (u *userUseCase) IssueToken(
+	ctx context.Context,
+	req *models.TokenRequest,
+	userAgent string,
+	ip string,
+) (*models.TokenResponse, *models.TwoFactorChallenge, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.IssueToken")
+	defer span.Finish()
+
+	switch req.GrantType {
+	case models.GrantTypeRefreshToken:
+		token, err := u.refreshToken(ctx, req.RefreshToken)
+		return token, nil, err
+	case models.GrantTypeTwoFactor:
+		verifiedUser, err := u.VerifyTwoFactorLogin(ctx, &models.TwoFactorLogin{PendingToken: req.PendingToken, Code: req.Code}, ip)
+		if err != nil {
+			return nil, nil, err
+		}
+		token, err := u.issueTokenPair(ctx, verifiedUser.UserID, verifiedUser.Role, userAgent, ip)
+		return token, nil, err
+	}
+
+	loggedUser, err := u.Login(ctx, models.Login{Email: req.Email, Password: req.Password}, ip)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if loggedUser.TwoFactorEnabledAt != nil {
+		challenge, err := u.CreatePendingLogin(ctx, loggedUser.UserID, userAgent, ip)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, challenge, nil
+	}
+
+	token, err := u.issueTokenPair(ctx, loggedUser.UserID, loggedUser.Role, userAgent, ip)
+	return token, nil, err
+}
+
+// issueTokenPair create refresh token family in sessions service and sign access token
+func (u *userUseCase) issueTokenPair(ctx context.Context, userID uuid.UUID, role *models.Role, userAgent string, ip string) (*models.TokenResponse, error) {
+	res, err := u.sessClient.CreateRefreshToken(ctx, &sessionService.CreateRefreshTokenRequest{
+		UserID:    userID.String(),
+		UserAgent: userAgent,
+		IP:        ip,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sessClient.CreateRefreshToken")
+	}
+
+	return u.makeTokenResponse(userID, role, res.GetFamilyID(), res.GetRefreshToken())
+}
+
+// refreshToken rotate refresh token, reused token revokes the whole token family in sessions service
+func (u *userUseCase) refreshToken(ctx context.Context, refreshToken string) (*models.TokenResponse, error) {
+	res, err := u.sessClient.RotateRefreshToken(ctx, &sessionService.RotateRefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return nil, httpErrors.InvalidRefreshToken
+		}
+		return nil, errors.Wrap(err, "sessClient.RotateRefreshToken")
+	}
+
+	userID, err := uuid.FromString(res.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "uuid.FromString")
+	}
+
+	userResponse, err := u.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetByID")
+	}
+	if userResponse.IsSuspended() {
+		return nil, httpErrors.AccountSuspended
+	}
+
+	return u.makeTokenResponse(userResponse.UserID, userResponse.Role, res.GetFamilyID(), res.GetRefreshToken())
+}
+
+// RevokeRefreshToken revoke refresh token family
+func (u *userUseCase) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.RevokeRefreshToken")
+	defer span.Finish()
+
+	if _, err := u.sessClient.RevokeRefreshToken(ctx, &sessionService.RevokeRefreshTokenRequest{RefreshToken: refreshToken}); err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return httpErrors.InvalidRefreshToken
+		}
+		return errors.Wrap(err, "sessClient.RevokeRefreshToken")
+	}
+
+	return nil
+}
+
+func (u *userUseCase) makeTokenResponse(userID uuid.UUID, role *models.Role, familyID string, refreshToken string) (*models.TokenResponse, error) {
+	now := time.Now().UTC()
+	ttl := u.cfg.JWT.AccessTokenTTL * time.Second
+
+	claims := models.AccessTokenClaims{
+		FamilyID: familyID,
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.NewV4().String(),
+			Subject:   userID.String(),
+			Issuer:    u.cfg.JWT.Issuer,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+	}
+	if role != nil {
+		claims.Role = role.ToString()
+	}
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(u.cfg.JWT.Secret))
+	if err != nil {
+		return nil, errors.Wrap(err, "jwt.SignedString")
+	}
+
+	return &models.TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    models.TokenTypeBearer,
+		ExpiresIn:    int64(ttl.Seconds()),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// OIDCAuthURL start authorization code flow, returns provider authorization url and state bound to client
+func (u *userUseCase) OIDCAuthURL(ctx context.Context, providerName string) (string, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.OIDCAuthURL")
+	defer span.Finish()
+
+	provider, ok := u.oidcProviders[providerName]
+	if !ok {
+		return "", "", httpErrors.NotFound
+	}
+
+	state, err := oidc.RandomString()
+	if err != nil {
+		return "", "", errors.Wrap(err, "oidc.RandomString")
+	}
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return "", "", errors.Wrap(err, "oidc.RandomString")
+	}
+	codeVerifier, err := oidc.RandomString()
+	if err != nil {
+		return "", "", errors.Wrap(err, "oidc.RandomString")
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return "", "", errors.Wrap(err, "provider.AuthCodeURL")
+	}
+
+	if err := u.oidcStateRepo.SaveState(ctx, state, &models.OIDCState{
+		Provider:     provider.Name(),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	}); err != nil {
+		return "", "", errors.Wrap(err, "oidcStateRepo.SaveState")
+	}
+
+	return authURL, state, nil
+}
+
+// OIDCCallback finish authorization code flow, returns user linked to provider account
+func (u *userUseCase) OIDCCallback(ctx context.Context, providerName string, callback *models.OIDCCallback) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.OIDCCallback")
+	defer span.Finish()
+
+	provider, ok := u.oidcProviders[providerName]
+	if !ok {
+		return nil, httpErrors.NotFound
+	}
+
+	oidcState, err := u.oidcStateRepo.ConsumeState(ctx, callback.State)
+	if err != nil {
+		if errors.Cause(err) == redis.Nil {
+			return nil, httpErrors.InvalidOIDCState
+		}
+		return nil, errors.Wrap(err, "oidcStateRepo.ConsumeState")
+	}
+	if oidcState.Provider != provider.Name() {
+		return nil, httpErrors.InvalidOIDCState
+	}
+
+	token, err := provider.Exchange(ctx, callback.Code, oidcState.CodeVerifier)
+	if err != nil {
+		u.log.Errorf("OIDCCallback provider.Exchange: %v", err)
+		return nil, httpErrors.OIDCAuthFailed
+	}
+
+	claims, err := provider.VerifyIDToken(ctx, token.IDToken, oidcState.Nonce)
+	if err != nil {
+		u.log.Errorf("OIDCCallback provider.VerifyIDToken: %v", err)
+		return nil, httpErrors.OIDCAuthFailed
+	}
+
+	linked, err := u.linkIdentity(ctx, provider.Name(), claims)
+	if err != nil {
+		return nil, err
+	}
+	if linked.IsSuspended() {
+		return nil, httpErrors.AccountSuspended
+	}
+
+	return linked, nil
+}
+
+// linkIdentity find user by provider account, existing user is linked only when both sides verified the email
+func (u *userUseCase) linkIdentity(ctx context.Context, providerName string, claims *oidc.IDTokenClaims) (*models.UserResponse, error) {
+	linked, err := u.userPGRepo.GetByIdentity(ctx, providerName, claims.Subject)
+	if err == nil {
+		return linked, nil
+	}
+	if errors.Cause(err) != pgx.ErrNoRows {
+		return nil, errors.Wrap(err, "userPGRepo.GetByIdentity")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(claims.Email))
+	if email == "" || !claims.EmailVerified {
+		return nil, httpErrors.OIDCAuthFailed
+	}
+
+	identity := &models.UserIdentity{Provider: providerName, Subject: claims.Subject, Email: email}
+
+	existing, err := u.userPGRepo.GetByEmail(ctx, email)
+	if err != nil && errors.Cause(err) != pgx.ErrNoRows {
+		return nil, errors.Wrap(err, "userPGRepo.GetByEmail")
+	}
+	if err == nil {
+		if existing.EmailVerifiedAt == nil {
+			return nil, httpErrors.ExistsEmailError
+		}
+		identity.UserID = existing.UserID
+		if _, err := u.userPGRepo.CreateIdentity(ctx, identity); err != nil {
+			return nil, errors.Wrap(err, "userPGRepo.CreateIdentity")
+		}
+		return u.GetByID(ctx, existing.UserID)
+	}
+
+	password, err := generateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "generateToken")
+	}
+
+	firstName, lastName := identityNames(claims)
+	verifiedAt := time.Now().UTC()
+	newUser := &models.User{
+		FirstName:       firstName,
+		LastName:        lastName,
+		Email:           email,
+		Password:        password,
+		EmailVerifiedAt: &verifiedAt,
+	}
+	if err := newUser.PreparePassword(); err != nil {
+		return nil, errors.Wrap(err, "PreparePassword")
+	}
+
+	created, err := u.userPGRepo.CreateWithIdentity(ctx, newUser, identity)
+	if err != nil {
+		return nil, errors.Wrap(err, "userPGRepo.CreateWithIdentity")
+	}
+
+	return created, nil
+}
+
+// identityNames names of new user from provider claims, users table requires both names
+func identityNames(claims *oidc.IDTokenClaims) (string, string) {
+	firstName, lastName := strings.TrimSpace(claims.GivenName), strings.TrimSpace(claims.FamilyName)
+	if firstName == "" || lastName == "" {
+		if parts := strings.Fields(claims.Name); len(parts) > 1 {
+			firstName, lastName = parts[0], strings.Join(parts[1:], " ")
+		}
+	}
+	if firstName == "" {
+		firstName = strings.Split(claims.Email, "@")[0]
+	}
+	if lastName == "" {
+		lastName = "-"
+	}
+	return truncate(firstName, maxNameLength), truncate(lastName, maxNameLength)
+}
+
+func truncate(s string, max int) string {
+	if runes := []rune(s); len(runes) > max {
+		return string(runes[:max])
+	}
+	return s
+}
+
+// CreatePendingLogin first login step passed, session is created after second factor check
+func (u *userUseCase) CreatePendingLogin(ctx context.Context, userID uuid.UUID, userAgent string, ip string) (*models.TwoFactorChallenge, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.CreatePendingLogin")
+	defer span.Finish()
+
+	res, err := u.sessClient.CreatePendingLogin(ctx, &sessionService.CreatePendingLoginRequest{
+		UserID:    userID.String(),
+		UserAgent: userAgent,
+		IP:        ip,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sessClient.CreatePendingLogin")
+	}
+
+	return &models.TwoFactorChallenge{
+		TwoFactorRequired: true,
+		PendingToken:      res.GetPendingToken(),
+		ExpiresAt:         res.GetExpiresAt().AsTime(),
+	}, nil
+}
+
+// VerifyTwoFactorLogin second login step, wrong codes count as failed logins of user email,
+// pending login is dropped and failed attempts are reset on success
+func (u *userUseCase) VerifyTwoFactorLogin(ctx context.Context, login *models.TwoFactorLogin, ip string) (*models.UserResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.VerifyTwoFactorLogin")
+	defer span.Finish()
+
+	pending, err := u.sessClient.VerifyPendingLogin(ctx, &sessionService.VerifyPendingLoginRequest{PendingToken: login.PendingToken})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return nil, httpErrors.Unauthorized
+		}
+		return nil, errors.Wrap(err, "sessClient.VerifyPendingLogin")
+	}
+
+	userID, err := uuid.FromString(pending.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "uuid.FromString")
+	}
+
+	userResponse, err := u.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetByID")
+	}
+	email := strings.ToLower(strings.TrimSpace(userResponse.Email))
+
+	if err := u.checkLoginAttempts(ctx, email, ip); err != nil {
+		return nil, err
+	}
+
+	if err := u.verifySecondFactor(ctx, userID, login.Code); err != nil {
+		if err == httpErrors.InvalidTwoFactorCode {
+			u.loginFailed(ctx, email, ip)
+		}
+		return nil, err
+	}
+
+	if _, err := u.sessClient.DeletePendingLogin(ctx, &sessionService.DeletePendingLoginRequest{PendingToken: login.PendingToken}); err != nil {
+		return nil, errors.Wrap(err, "sessClient.DeletePendingLogin")
+	}
+	u.loginSucceeded(ctx, email)
+
+	if userResponse.IsSuspended() {
+		return nil, httpErrors.AccountSuspended
+	}
+
+	return userResponse, nil
+}
+
+// EnrollTwoFactor generate new secret, two-factor is enabled after first code confirmation
+func (u *userUseCase) EnrollTwoFactor(ctx context.Context, enroll *models.TwoFactorEnroll) (*models.TwoFactorEnrollment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.EnrollTwoFactor")
+	defer span.Finish()
+
+	ctxUser, _, err := u.reauthenticate(ctx, enroll.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if ctxUser.IsTwoFactorEnabled() {
+		return nil, httpErrors.TwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "totp.GenerateSecret")
+	}
+	encrypted, err := u.totpCipher.Encrypt(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "totpCipher.Encrypt")
+	}
+
+	if err := u.userPGRepo.SetTwoFactorSecret(ctx, ctxUser.UserID, encrypted); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, httpErrors.TwoFactorAlreadyEnabled
+		}
+		return nil, errors.Wrap(err, "userPGRepo.SetTwoFactorSecret")
+	}
+
+	return &models.TwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(u.cfg.TwoFactor.Issuer, ctxUser.Email, secret),
+	}, nil
+}
+
+// ConfirmTwoFactor enable two-factor with the first valid code, returns recovery codes
+func (u *userUseCase) ConfirmTwoFactor(ctx context.Context, code string) (*models.RecoveryCodes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.ConfirmTwoFactor")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+
+	twoFactor, err := u.userPGRepo.GetTwoFactor(ctx, ctxUser.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "userPGRepo.GetTwoFactor")
+	}
+	if twoFactor.EnabledAt != nil {
+		return nil, httpErrors.TwoFactorAlreadyEnabled
+	}
+	if twoFactor.Secret == nil {
+		return nil, httpErrors.TwoFactorNotEnrolled
+	}
+
+	secret, err := u.totpCipher.Decrypt(*twoFactor.Secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "totpCipher.Decrypt")
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return nil, httpErrors.InvalidTwoFactorCode
+	}
+
+	recoveryCodes, hashes, err := u.generateRecoveryCodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "generateRecoveryCodes")
+	}
+
+	if err := u.userPGRepo.EnableTwoFactor(ctx, ctxUser.UserID, step, hashes); err != nil {
+		return nil, errors.Wrap(err, "userPGRepo.EnableTwoFactor")
+	}
+
+	if err := u.redisRepo.DeleteUser(ctx, ctxUser.UserID); err != nil {
+		u.log.Errorf("redisRepo.DeleteUser: %v", err)
+	}
+
+	return &models.RecoveryCodes{Codes: recoveryCodes}, nil
+}
+
+// DisableTwoFactor requires password and second factor, admins must keep two-factor enabled
+func (u *userUseCase) DisableTwoFactor(ctx context.Context, disable *models.TwoFactorDisable) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.DisableTwoFactor")
+	defer span.Finish()
+
+	ctxUser, _, err := u.reauthenticate(ctx, disable.CurrentPassword)
+	if err != nil {
+		return err
+	}
+	if ctxUser.IsAdmin() {
+		return httpErrors.TwoFactorEnrollmentRequired
+	}
+
+	if err := u.verifySecondFactor(ctx, ctxUser.UserID, disable.Code); err != nil {
+		return err
+	}
+
+	if err := u.userPGRepo.DisableTwoFactor(ctx, ctxUser.UserID); err != nil {
+		return errors.Wrap(err, "userPGRepo.DisableTwoFactor")
+	}
+
+	if err := u.redisRepo.DeleteUser(ctx, ctxUser.UserID); err != nil {
+		u.log.Errorf("redisRepo.DeleteUser: %v", err)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replace recovery codes, requires current TOTP code
+func (u *userUseCase) RegenerateRecoveryCodes(ctx context.Context, code string) (*models.RecoveryCodes, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "userUseCase.RegenerateRecoveryCodes")
+	defer span.Finish()
+
+	ctxUser, ok := ctx.Value(middlewares.RequestCtxUser{}).(*models.UserResponse)
+	if !ok {
+		return nil, errors.Wrap(httpErrors.Unauthorized, "ctx.Value user")
+	}
+	if !totp.IsCode(code) {
+		return nil, httpErrors.InvalidTwoFactorCode
+	}
+
+	if err := u.verifySecondFactor(ctx, ctxUser.UserID, code); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, hashes, err := u.generateRecoveryCodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "generateRecoveryCodes")
+	}
+
+	if err := u.userPGRepo.ReplaceRecoveryCodes(ctx, ctxUser.UserID, hashes); err != nil {
+		return nil, errors.Wrap(err, "userPGRepo.ReplaceRecoveryCodes")
+	}
+
+	return &models.RecoveryCodes{Codes: recoveryCodes}, nil
+}
+
+// verifySecondFactor accepts TOTP code once per time step or unused recovery code
+func (u *userUseCase) verifySecondFactor(ctx context.Context, userID uuid.UUID, code string) error {
+	twoFactor, err := u.userPGRepo.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "userPGRepo.GetTwoFactor")
+	}
+	if twoFactor.EnabledAt == nil || twoFactor.Secret == nil {
+		return httpErrors.TwoFactorNotEnrolled
+	}
+
+	code = strings.TrimSpace(code)
+	if totp.IsCode(code) {
+		secret, err := u.totpCipher.Decrypt(*twoFactor.Secret)
+		if err != nil {
+			return errors.Wrap(err, "totpCipher.Decrypt")
+		}
+
+		step, ok := totp.Validate(secret, code, time.Now())
+		if !ok {
+			return httpErrors.InvalidTwoFactorCode
+		}
+
+		used, err := u.userPGRepo.UseTwoFactorStep(ctx, userID, step)
+		if err != nil {
+			return errors.Wrap(err, "userPGRepo.UseTwoFactorStep")
+		}
+		if !used {
+			return httpErrors.InvalidTwoFactorCode
+		}
+		return nil
+	}
+
+	used, err := u.userPGRepo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	if err != nil {
+		return errors.Wrap(err, "userPGRepo.UseRecoveryCode")
+	}
+	if !used {
+		return httpErrors.InvalidTwoFactorCode
+	}
+	u.log.Infof("recovery code used, user: %s", userID)
+
+	return nil
+}
+
+// generateRecoveryCodes returns plain codes formatted as xxxxx-xxxxx and their hashes
+func (u *userUseCase) generateRecoveryCodes() ([]string, []string, error) {
+	count := u.cfg.TwoFactor.RecoveryCodesCount
+	recoveryCodes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		b := make([]byte, recoveryCodeBytes*2)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := hex.EncodeToString(b[:recoveryCodeBytes]) + "-" + hex.EncodeToString(b[recoveryCodeBytes:])
+		recoveryCodes = append(recoveryCodes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return recoveryCodes, hashes, nil
+}
+
+// hashRecoveryCode codes have enough entropy for plain sha256, separators and case are ignored
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
 }
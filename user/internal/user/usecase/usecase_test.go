@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/models"
+	"github.com/AleksK1NG/hotels-mocroservices/user/internal/user"
+	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/totp"
+)
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// twoFactorRepo in memory two-factor state, other user.PGRepository methods are not used by tests
+type twoFactorRepo struct {
+	user.PGRepository
+	twoFactor     *models.TwoFactor
+	lastStep      int64
+	recoveryCodes map[string]bool
+}
+
+func (r *twoFactorRepo) GetTwoFactor(ctx context.Context, userID uuid.UUID) (*models.TwoFactor, error) {
+	return r.twoFactor, nil
+}
+
+func (r *twoFactorRepo) UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	if step <= r.lastStep {
+		return false, nil
+	}
+	r.lastStep = step
+	return true, nil
+}
+
+func (r *twoFactorRepo) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	used, ok := r.recoveryCodes[codeHash]
+	if !ok || used {
+		return false, nil
+	}
+	r.recoveryCodes[codeHash] = true
+	return true, nil
+}
+
+func newTestLogger() logger.Logger {
+	apiLogger := logger.NewApiLogger(&config.Config{Logger: config.Logger{Level: "fatal", Encoding: "console"}})
+	apiLogger.InitLogger()
+	return apiLogger
+}
+
+func newTwoFactorUseCase(t *testing.T, recoveryCodes ...string) (*userUseCase, *twoFactorRepo) {
+	t.Helper()
+	cipher, err := totp.NewCipher(strings.Repeat("ab", 32))
+	if err != nil {
+		t.Fatalf("NewCipher: %v", err)
+	}
+	secret, err := cipher.Encrypt(testTOTPSecret)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	enabledAt := time.Now().UTC()
+	repo := &twoFactorRepo{
+		twoFactor:     &models.TwoFactor{Secret: &secret, EnabledAt: &enabledAt},
+		recoveryCodes: make(map[string]bool),
+	}
+	for _, code := range recoveryCodes {
+		repo.recoveryCodes[hashRecoveryCode(code)] = false
+	}
+
+	return &userUseCase{cfg: &config.Config{}, userPGRepo: repo, totpCipher: cipher, log: newTestLogger()}, repo
+}
+
+// totpCode RFC 6238 code of test secret for time step
+func totpCode(t *testing.T, step int64) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(testTOTPSecret)
+	if err != nil {
+		t.Fatalf("DecodeString: %v", err)
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%06d", value%1000000)
+}
+
+func TestVerifySecondFactorTOTPStepReuse(t *testing.T) {
+	// codes are generated for steps around now, don't let the step change before they are validated
+	if time.Now().Unix()%totp.Period >= totp.Period-2 {
+		time.Sleep(3 * time.Second)
+	}
+	current := time.Now().Unix() / totp.Period
+
+	tests := []struct {
+		name    string
+		codes   []string
+		wantErr []error
+	}{
+		{
+			name:    "code accepted once",
+			codes:   []string{totpCode(t, current), totpCode(t, current)},
+			wantErr: []error{nil, httpErrors.InvalidTwoFactorCode},
+		},
+		{
+			name:    "older step rejected after newer step used",
+			codes:   []string{totpCode(t, current), totpCode(t, current-1)},
+			wantErr: []error{nil, httpErrors.InvalidTwoFactorCode},
+		},
+		{
+			name:    "previous then current step",
+			codes:   []string{totpCode(t, current-1), totpCode(t, current)},
+			wantErr: []error{nil, nil},
+		},
+		{
+			name:    "code with surrounding spaces",
+			codes:   []string{" " + totpCode(t, current) + " "},
+			wantErr: []error{nil},
+		},
+		{
+			name:    "expired step",
+			codes:   []string{totpCode(t, current-3)},
+			wantErr: []error{httpErrors.InvalidTwoFactorCode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newTwoFactorUseCase(t)
+			for i, code := range tt.codes {
+				if err := uc.verifySecondFactor(context.Background(), uuid.NewV4(), code); err != tt.wantErr[i] {
+					t.Fatalf("verifySecondFactor code #%d error = %v, want %v", i, err, tt.wantErr[i])
+				}
+			}
+		})
+	}
+}
+
+func TestVerifySecondFactorRecoveryCodes(t *testing.T) {
+	const recoveryCode = "0a1b2c3d4e-5f6a7b8c9d"
+
+	tests := []struct {
+		name    string
+		codes   []string
+		wantErr []error
+	}{
+		{
+			name:    "recovery code accepted once",
+			codes:   []string{recoveryCode, recoveryCode},
+			wantErr: []error{nil, httpErrors.InvalidTwoFactorCode},
+		},
+		{
+			name:    "separators and case are ignored",
+			codes:   []string{"0A1B2 C3D4E5F6A7B8C9D"},
+			wantErr: []error{nil},
+		},
+		{
+			name:    "unknown recovery code",
+			codes:   []string{"ffffffffff-ffffffffff"},
+			wantErr: []error{httpErrors.InvalidTwoFactorCode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newTwoFactorUseCase(t, recoveryCode)
+			for i, code := range tt.codes {
+				if err := uc.verifySecondFactor(context.Background(), uuid.NewV4(), code); err != tt.wantErr[i] {
+					t.Fatalf("verifySecondFactor code #%d error = %v, want %v", i, err, tt.wantErr[i])
+				}
+			}
+		})
+	}
+}
+
+func TestVerifySecondFactorNotEnrolled(t *testing.T) {
+	uc, repo := newTwoFactorUseCase(t)
+	repo.twoFactor.EnabledAt = nil
+
+	if err := uc.verifySecondFactor(context.Background(), uuid.NewV4(), "123456"); err != httpErrors.TwoFactorNotEnrolled {
+		t.Errorf("verifySecondFactor error = %v, want %v", err, httpErrors.TwoFactorNotEnrolled)
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+	}{
+		{name: "configured count", count: 10},
+		{name: "single code", count: 1},
+		{name: "no codes", count: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &userUseCase{cfg: &config.Config{TwoFactor: config.TwoFactor{RecoveryCodesCount: tt.count}}}
+
+			codes, hashes, err := uc.generateRecoveryCodes()
+			if err != nil {
+				t.Fatalf("generateRecoveryCodes: %v", err)
+			}
+			if len(codes) != tt.count || len(hashes) != tt.count {
+				t.Fatalf("generated %d codes and %d hashes, want %d", len(codes), len(hashes), tt.count)
+			}
+
+			seen := make(map[string]bool, len(codes))
+			for i, code := range codes {
+				if len(code) != recoveryCodeBytes*4+1 || code[recoveryCodeBytes*2] != '-' || totp.IsCode(code) {
+					t.Errorf("code %q is not formatted as xxxxxxxxxx-xxxxxxxxxx", code)
+				}
+				if hashes[i] != hashRecoveryCode(code) || hashes[i] == code {
+					t.Errorf("hash of code %q = %q, want sha256 of normalized code", code, hashes[i])
+				}
+				if seen[code] {
+					t.Errorf("duplicate code %q", code)
+				}
+				seen[code] = true
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP WITH TIME ZONE;
+
+-- accounts created before email verification was introduced are treated as verified,
+-- only users registered after this migration have to confirm their email
+UPDATE users SET email_verified_at = COALESCE(created_at, now()) WHERE email_verified_at IS NULL;
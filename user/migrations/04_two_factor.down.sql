@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS user_recovery_codes CASCADE;
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_last_step;
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_enabled_at;
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_secret;
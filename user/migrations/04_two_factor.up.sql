@@ -0,0 +1,13 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_secret TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_enabled_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_last_step BIGINT;
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes
+(
+    code_id    UUID PRIMARY KEY         DEFAULT uuid_generate_v4(),
+    user_id    UUID NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    code_hash  TEXT NOT NULL CHECK ( code_hash <> '' ),
+    used_at    TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, code_hash)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS users_created_at_user_id_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE users DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_at;
@@ -10,6 +10,8 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	httpErrors "github.com/AleksK1NG/hotels-mocroservices/user/pkg/http_errors"
 )
 
 var (
@@ -17,6 +19,8 @@ var (
 	ErrNoCtxMetaData    = errors.New("No ctx metadata")
 	ErrInvalidSessionId = errors.New("Invalid session id")
 	ErrEmailExists      = errors.New("Email already exists")
+	ErrTooManyUserIDs   = errors.New("Too many user ids, use StreamUsersByIDs for large sets")
+	ErrPermissionDenied = errors.New("Permission denied")
 )
 
 // Parse error and get code
@@ -34,7 +38,16 @@ func ParseGRPCErrStatusCode(err error) codes.Code {
 		return codes.Unauthenticated
 	case errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
-	case strings.Contains(err.Error(), "Validate"):
+	case errors.Is(err, ErrTooManyUserIDs), errors.Is(err, httpErrors.CannotManageOwnUser):
+		return codes.InvalidArgument
+	case errors.Is(err, ErrPermissionDenied), errors.Is(err, httpErrors.PermissionDenied),
+		errors.Is(err, httpErrors.TwoFactorEnrollmentRequired):
+		return codes.PermissionDenied
+	case errors.Is(err, httpErrors.Unauthorized):
+		return codes.Unauthenticated
+	case errors.Is(err, httpErrors.NotFound):
+		return codes.NotFound
+	case strings.Contains(err.Error(), "Validate"), strings.Contains(err.Error(), "Field validation"):
 		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "redis"):
 		return codes.NotFound
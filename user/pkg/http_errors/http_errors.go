@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgerrcode"
 	"github.com/labstack/echo/v4"
@@ -26,6 +29,7 @@ const (
 	ErrInvalidEmail     = "Invalid email"
 	ErrInvalidPassword  = "Invalid password"
 	ErrInvalidField     = "Invalid field"
+	ErrTooManyRequests  = "Too many requests"
 )
 
 var (
@@ -48,8 +52,41 @@ var (
 	NotAllowedImageHeader = errors.New("Not allowed image header")
 	NoCookie              = errors.New("not found cookie header")
 	InvalidUUID           = errors.New("invalid uuid")
+	InvalidResetToken     = errors.New("Invalid or expired password reset token")
+	InvalidVerifyToken    = errors.New("Invalid or expired email verification token")
+	EmailAlreadyVerified  = errors.New("Email already verified")
+	InvalidRefreshToken   = errors.New("Invalid or expired refresh token")
+	InvalidOIDCState      = errors.New("Invalid or expired OIDC state")
+	OIDCAuthFailed        = errors.New("OIDC authentication failed")
+	TooManyLoginAttempts  = errors.New("Too many failed login attempts, try again later")
+	AccountSuspended      = errors.New("Account suspended")
+	CannotManageOwnUser   = errors.New("Admin can't change role, suspend or delete own account")
+
+	InvalidTwoFactorCode        = errors.New("Invalid two-factor code")
+	TwoFactorAlreadyEnabled     = errors.New("Two-factor authentication already enabled")
+	TwoFactorNotEnrolled        = errors.New("Two-factor authentication is not enrolled")
+	TwoFactorEnrollmentRequired = errors.New("Two-factor authentication enrollment required")
 )
 
+// RetryAfterError error with time after which request can be retried, sent in Retry-After header
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+// NewRetryAfterError wrap err with time to retry
+func NewRetryAfterError(err error, retryAfter time.Duration) *RetryAfterError {
+	return &RetryAfterError{Err: err, RetryAfter: retryAfter}
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
 // Rest error interface
 type RestErr interface {
 	Status() int
@@ -169,6 +206,25 @@ func ParseErrors(err error) RestErr {
 		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, nil)
 	case errors.Is(err, WrongCredentials):
 		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, nil)
+	case errors.Is(err, InvalidRefreshToken), errors.Is(err, OIDCAuthFailed),
+		errors.Is(err, InvalidTwoFactorCode):
+		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, err.Error())
+	case errors.Is(err, WrongCSRFToken), errors.Is(err, CSRFNotPresented), errors.Is(err, ExpiredCSRFError):
+		return NewRestError(http.StatusForbidden, ErrForbidden, err.Error())
+	case errors.Is(err, NotFound):
+		return NewRestError(http.StatusNotFound, ErrNotFound, nil)
+	case errors.Is(err, InvalidResetToken), errors.Is(err, InvalidVerifyToken), errors.Is(err, EmailAlreadyVerified),
+		errors.Is(err, InvalidOIDCState), errors.Is(err, TwoFactorAlreadyEnabled), errors.Is(err, TwoFactorNotEnrolled),
+		errors.Is(err, CannotManageOwnUser):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error())
+	case errors.Is(err, TwoFactorEnrollmentRequired), errors.Is(err, AccountSuspended):
+		return NewRestError(http.StatusForbidden, ErrForbidden, err.Error())
+	case errors.Is(err, Forbidden), errors.Is(err, PermissionDenied):
+		return NewRestError(http.StatusForbidden, ErrForbidden, nil)
+	case errors.Is(err, TooManyLoginAttempts):
+		return NewRestError(http.StatusTooManyRequests, ErrTooManyRequests, err.Error())
+	case errors.Is(err, ExistsEmailError):
+		return NewRestError(http.StatusConflict, ErrAlreadyExists, err.Error())
 	case strings.Contains(strings.ToLower(err.Error()), "sqlstate"):
 		return parseSqlErrors(err)
 	case strings.Contains(strings.ToLower(err.Error()), "field validation"):
@@ -219,5 +275,10 @@ func ErrorResponse(err error) (int, interface{}) {
 // Error response object and status code
 func ErrorCtxResponse(ctx echo.Context, err error) error {
 	restErr := ParseErrors(err)
+	var retryErr *RetryAfterError
+	if errors.As(err, &retryErr) && retryErr.RetryAfter > 0 {
+		seconds := int(math.Ceil(retryErr.RetryAfter.Seconds()))
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+	}
 	return ctx.JSON(restErr.Status(), restErr.ErrBody())
 }
@@ -0,0 +1,60 @@
+package httpErrors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestErrorCtxResponseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     int
+		wantRetryAfter string
+	}{
+		{
+			name:           "throttled login",
+			err:            NewRetryAfterError(TooManyLoginAttempts, 2*time.Second),
+			wantStatus:     http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+		},
+		{
+			name:           "partial seconds are rounded up",
+			err:            NewRetryAfterError(TooManyLoginAttempts, 1500*time.Millisecond),
+			wantStatus:     http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+		},
+		{
+			name:       "no retry time",
+			err:        NewRetryAfterError(TooManyLoginAttempts, 0),
+			wantStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:       "plain error",
+			err:        WrongCredentials,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/login", nil), rec)
+
+			if err := ErrorCtxResponse(c, tt.err); err != nil {
+				t.Fatalf("ErrorCtxResponse: %v", err)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ type Logger interface {
 	Infof(template string, args ...interface{})
 	Warn(args ...interface{})
 	Warnf(template string, args ...interface{})
+	Warnw(msg string, keysAndValues ...interface{})
 	Error(args ...interface{})
 	Errorf(template string, args ...interface{})
 	DPanic(args ...interface{})
@@ -119,6 +120,10 @@ func (l *apiLogger) Warnf(template string, args ...interface{}) {
 	l.sugarLogger.Warnf(template, args...)
 }
 
+func (l *apiLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	l.sugarLogger.Warnw(msg, keysAndValues...)
+}
+
 func (l *apiLogger) Error(args ...interface{}) {
 	l.sugarLogger.Error(args...)
 }
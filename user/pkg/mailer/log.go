@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+)
+
+type logMailer struct {
+	logger   logger.Logger
+	filePath string
+}
+
+// NewLogMailer writes emails to the log and appends them to file if path is configured
+func NewLogMailer(cfg *config.Config, logger logger.Logger) *logMailer {
+	return &logMailer{logger: logger, filePath: cfg.Mailer.FilePath}
+}
+
+func (m *logMailer) Send(ctx context.Context, to string, subject string, body string) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "logMailer.Send")
+	defer span.Finish()
+
+	m.logger.Infof("logMailer.Send to: %s, subject: %s, body: %s", to, subject, body)
+
+	if m.filePath == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(m.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "os.OpenFile")
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintf(f, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n", time.Now().UTC().Format(time.RFC1123Z), to, subject, body); err != nil {
+		return errors.Wrap(err, "fmt.Fprintf")
+	}
+	return nil
+}
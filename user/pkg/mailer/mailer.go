@@ -0,0 +1,32 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/logger"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverLog  = "log"
+)
+
+// Mailer send plain text emails
+type Mailer interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
+
+// NewMailer returns mailer for configured driver, log driver is intended for local development
+func NewMailer(cfg *config.Config, logger logger.Logger) (Mailer, error) {
+	switch cfg.Mailer.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(cfg), nil
+	case DriverLog, "":
+		return NewLogMailer(cfg, logger), nil
+	default:
+		return nil, errors.Errorf("unknown mailer driver: %s", cfg.Mailer.Driver)
+	}
+}
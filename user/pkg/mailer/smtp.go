@@ -0,0 +1,51 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+)
+
+type smtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer smtp mailer, uses plain auth when username is configured
+func NewSMTPMailer(cfg *config.Config) *smtpMailer {
+	var auth smtp.Auth
+	if cfg.Mailer.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Mailer.Username, cfg.Mailer.Password, cfg.Mailer.Host)
+	}
+	return &smtpMailer{
+		addr: net.JoinHostPort(cfg.Mailer.Host, cfg.Mailer.Port),
+		from: cfg.Mailer.From,
+		auth: auth,
+	}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, to string, subject string, body string) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "smtpMailer.Send")
+	defer span.Finish()
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", m.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n")
+	msg.WriteString(body)
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{to}, msg.Bytes()); err != nil {
+		return errors.Wrap(err, "smtp.SendMail")
+	}
+	return nil
+}
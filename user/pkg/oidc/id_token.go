@@ -0,0 +1,194 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+// clockSkew allowed between provider and service clocks
+const clockSkew = time.Minute
+
+// IDTokenClaims standard OpenID Connect claims used to link identities
+type IDTokenClaims struct {
+	Issuer        string   `json:"iss"`
+	Subject       string   `json:"sub"`
+	Audience      audience `json:"aud"`
+	ExpiresAt     int64    `json:"exp"`
+	IssuedAt      int64    `json:"iat"`
+	Nonce         string   `json:"nonce"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+	Name          string   `json:"name"`
+	GivenName     string   `json:"given_name"`
+	FamilyName    string   `json:"family_name"`
+}
+
+// Valid expiration check, called by jwt parser
+func (c *IDTokenClaims) Valid() error {
+	now := time.Now()
+	if c.ExpiresAt == 0 || now.After(time.Unix(c.ExpiresAt, 0).Add(clockSkew)) {
+		return errors.New("id token is expired")
+	}
+	if c.IssuedAt != 0 && now.Add(clockSkew).Before(time.Unix(c.IssuedAt, 0)) {
+		return errors.New("id token used before issued")
+	}
+	return nil
+}
+
+// audience aud claim is either a string or an array of strings
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(b, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+func (a audience) contains(clientID string) bool {
+	for _, aud := range a {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type keySet struct {
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// keysRefreshInterval min interval of jwks refetch on unknown key id
+const keysRefreshInterval = time.Minute
+
+// VerifyIDToken check RS256 signature by provider jwks, issuer, audience and nonce
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken string, nonce string) (*IDTokenClaims, error) {
+	meta, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &IDTokenClaims{}
+	if _, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, errors.Errorf("unexpected id token signing method: %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, meta.JWKSURI, kid)
+	}); err != nil {
+		return nil, errors.Wrap(err, "jwt.ParseWithClaims")
+	}
+
+	if claims.Issuer != p.cfg.Issuer {
+		return nil, errors.Errorf("id token issuer mismatch: %q", claims.Issuer)
+	}
+	if !claims.Audience.contains(p.cfg.ClientID) {
+		return nil, errors.New("id token audience mismatch")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, errors.New("id token nonce mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("id token without subject")
+	}
+
+	return claims, nil
+}
+
+// getKey returns cached key by id, keys are refetched when id is unknown to support provider key rotation
+func (p *Provider) getKey(ctx context.Context, jwksURI string, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys != nil {
+		if key := p.keys.find(kid); key != nil {
+			return key, nil
+		}
+		if time.Since(p.keys.fetchedAt) < keysRefreshInterval {
+			return nil, errors.Errorf("unknown id token key: %q", kid)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "http.NewRequestWithContext")
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.doJSON(req, &jwks); err != nil {
+		return nil, errors.Wrap(err, "oidc jwks")
+	}
+
+	keys := &keySet{keys: make(map[string]*rsa.PublicKey, len(jwks.Keys)), fetchedAt: time.Now()}
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.rsaPublicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid jwk: %q", jwk.Kid)
+		}
+		keys.keys[jwk.Kid] = key
+	}
+	p.keys = keys
+
+	if key := p.keys.find(kid); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("unknown id token key: %q", kid)
+}
+
+// find key by id, token without key id is accepted only when provider publishes single key
+func (k *keySet) find(kid string) *rsa.PublicKey {
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key
+		}
+	}
+	return k.keys[kid]
+}
+
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode exponent")
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > int64(^uint32(0)>>1) || exponent.Int64() < 3 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
@@ -0,0 +1,192 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/config"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	httpTimeout   = 10 * time.Second
+	maxBodySize   = 1 << 20
+)
+
+// Provider OpenID Connect authorization code flow client with PKCE
+type Provider struct {
+	cfg        config.OIDCProvider
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      *keySet
+}
+
+// discovery subset of provider metadata used by authorization code flow
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// TokenResponse of token endpoint
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// NewProviders returns configured providers by name, metadata is fetched lazily on first use
+func NewProviders(cfg *config.Config) (map[string]*Provider, error) {
+	providers := make(map[string]*Provider, len(cfg.OIDC.Providers))
+	for _, providerCfg := range cfg.OIDC.Providers {
+		if providerCfg.Name == "" || providerCfg.Issuer == "" || providerCfg.ClientID == "" || providerCfg.RedirectURL == "" {
+			return nil, errors.Errorf("invalid oidc provider config: %q", providerCfg.Name)
+		}
+		if _, ok := providers[providerCfg.Name]; ok {
+			return nil, errors.Errorf("duplicated oidc provider: %q", providerCfg.Name)
+		}
+		providers[providerCfg.Name] = NewProvider(providerCfg, &http.Client{Timeout: httpTimeout})
+	}
+	return providers, nil
+}
+
+// NewProvider
+func NewProvider(cfg config.OIDCProvider, httpClient *http.Client) *Provider {
+	return &Provider{cfg: cfg, httpClient: httpClient}
+}
+
+// Name of provider used in routes and stored identities
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL returns provider authorization url with S256 code challenge
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	meta, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientID},
+		"redirect_uri":          {p.cfg.RedirectURL},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {CodeChallengeS256(codeVerifier)},
+		"code_challenge_method": {"S256"},
+	}
+
+	authURL, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "url.Parse")
+	}
+	if authURL.RawQuery != "" {
+		authURL.RawQuery += "&" + params.Encode()
+	} else {
+		authURL.RawQuery = params.Encode()
+	}
+
+	return authURL.String(), nil
+}
+
+// Exchange authorization code and code verifier for tokens
+func (p *Provider) Exchange(ctx context.Context, code string, codeVerifier string) (*TokenResponse, error) {
+	meta, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "http.NewRequestWithContext")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var token TokenResponse
+	if err := p.doJSON(req, &token); err != nil {
+		return nil, errors.Wrap(err, "oidc token exchange")
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("oidc token response without id_token")
+	}
+
+	return &token, nil
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.cfg.Issuer, "/")+discoveryPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "http.NewRequestWithContext")
+	}
+
+	var meta discovery
+	if err := p.doJSON(req, &meta); err != nil {
+		return nil, errors.Wrap(err, "oidc discovery")
+	}
+	if meta.Issuer != p.cfg.Issuer {
+		return nil, errors.Errorf("oidc discovery issuer mismatch: %q", meta.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("oidc discovery incomplete provider metadata")
+	}
+
+	p.discovery = &meta
+	return p.discovery, nil
+}
+
+func (p *Provider) doJSON(req *http.Request, dest interface{}) error {
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "httpClient.Do")
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxBodySize))
+	if err != nil {
+		return errors.Wrap(err, "ioutil.ReadAll")
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status: %d, body: %s", res.StatusCode, body)
+	}
+
+	return json.Unmarshal(body, dest)
+}
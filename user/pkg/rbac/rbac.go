@@ -0,0 +1,57 @@
+package rbac
+
+import userService "github.com/AleksK1NG/hotels-mocroservices/user/proto/user"
+
+// Permission action on resource, own suffix means the resource must belong to the user.
+// Permissions are proto enum values, other services get them with user from user service and never map roles themselves
+type Permission = userService.Permission
+
+const (
+	HotelCreate     = userService.Permission_HOTEL_CREATE
+	HotelUpdateOwn  = userService.Permission_HOTEL_UPDATE_OWN
+	HotelUpdateAny  = userService.Permission_HOTEL_UPDATE_ANY
+	CommentModerate = userService.Permission_COMMENT_MODERATE
+	UserUpdateAny   = userService.Permission_USER_UPDATE_ANY
+	UserManage      = userService.Permission_USER_MANAGE
+)
+
+// User roles, admin, user and owner are database role type values, guest and member user service model roles
+const (
+	RoleGuest  = "guest"
+	RoleUser   = "user"
+	RoleMember = "member"
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleGuest:  {},
+	RoleUser:   {},
+	RoleMember: {},
+	RoleOwner:  {HotelCreate, HotelUpdateOwn},
+	RoleAdmin:  {HotelCreate, HotelUpdateOwn, HotelUpdateAny, CommentModerate, UserUpdateAny, UserManage},
+}
+
+// RolePermissions permissions granted to role, unknown roles have no permissions
+func RolePermissions(role string) []Permission {
+	return append([]Permission(nil), rolePermissions[role]...)
+}
+
+// HasPermission check role has all given permissions, unknown roles have no permissions
+func HasPermission(role string, permissions ...Permission) bool {
+	for _, permission := range permissions {
+		if !hasPermission(role, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasPermission(role string, permission Permission) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package rbac
+
+import (
+	"testing"
+
+	userService "github.com/AleksK1NG/hotels-mocroservices/user/proto/user"
+)
+
+func TestRolePermissions(t *testing.T) {
+	all := []Permission{HotelCreate, HotelUpdateOwn, HotelUpdateAny, CommentModerate, UserUpdateAny, UserManage}
+
+	tests := []struct {
+		role    string
+		granted []Permission
+	}{
+		{role: RoleGuest},
+		{role: RoleUser},
+		{role: RoleMember},
+		{role: RoleOwner, granted: []Permission{HotelCreate, HotelUpdateOwn}},
+		{role: RoleAdmin, granted: all},
+		{role: ""},
+		{role: "superuser"},
+		{role: "Admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			granted := make(map[Permission]bool, len(tt.granted))
+			for _, permission := range tt.granted {
+				granted[permission] = true
+			}
+
+			for _, permission := range all {
+				if got := HasPermission(tt.role, permission); got != granted[permission] {
+					t.Errorf("HasPermission(%q, %v) = %v, want %v", tt.role, permission, got, granted[permission])
+				}
+			}
+
+			if got := RolePermissions(tt.role); len(got) != len(tt.granted) {
+				t.Errorf("RolePermissions(%q) = %v, want %v", tt.role, got, tt.granted)
+			}
+		})
+	}
+}
+
+func TestHasPermissionRequiresAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		role        string
+		permissions []Permission
+		want        bool
+	}{
+		{name: "owner has all own hotel permissions", role: RoleOwner, permissions: []Permission{HotelCreate, HotelUpdateOwn}, want: true},
+		{name: "owner lacks one of permissions", role: RoleOwner, permissions: []Permission{HotelUpdateOwn, HotelUpdateAny}, want: false},
+		{name: "admin has all permissions", role: RoleAdmin, permissions: []Permission{CommentModerate, UserManage}, want: true},
+		{name: "unspecified permission", role: RoleAdmin, permissions: []Permission{userService.Permission_PERMISSION_UNSPECIFIED}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasPermission(tt.role, tt.permissions...); got != tt.want {
+				t.Errorf("HasPermission(%q, %v) = %v, want %v", tt.role, tt.permissions, got, tt.want)
+			}
+		})
+	}
+}
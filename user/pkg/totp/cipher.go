@@ -0,0 +1,66 @@
+package totp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+// Cipher encrypts shared secrets at rest with AES-256-GCM
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher key is hex encoded 32 bytes
+func NewCipher(hexKey string) (*Cipher, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "hex.DecodeString")
+	}
+	if len(key) != 32 {
+		return nil, errors.New("two factor encryption key must be 32 bytes")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "aes.NewCipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "cipher.NewGCM")
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt returns base64 of nonce and sealed secret
+func (c *Cipher) Encrypt(secret string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "rand.Read")
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt
+func (c *Cipher) Decrypt(encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", errors.Wrap(err, "base64.DecodeString")
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	secret, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "aead.Open")
+	}
+	return string(secret), nil
+}
@@ -0,0 +1,120 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret base32 of RFC 6238 SHA1 test key "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateRFCVectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, tt.code, time.Unix(tt.unix, 0))
+			if !ok {
+				t.Fatalf("Validate(%q) at %d = false, want true", tt.code, tt.unix)
+			}
+			if want := tt.unix / Period; step != want {
+				t.Errorf("step = %d, want %d", step, want)
+			}
+		})
+	}
+}
+
+func TestValidateSteps(t *testing.T) {
+	key, err := encoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatalf("DecodeString: %v", err)
+	}
+	now := time.Unix(1111111109, 0)
+	current := now.Unix() / Period
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", secret: rfcSecret, code: generate(key, current), wantStep: current, wantOK: true},
+		{name: "previous step within skew", secret: rfcSecret, code: generate(key, current-1), wantStep: current - 1, wantOK: true},
+		{name: "next step within skew", secret: rfcSecret, code: generate(key, current+1), wantStep: current + 1, wantOK: true},
+		{name: "step outside skew", secret: rfcSecret, code: generate(key, current-2)},
+		{name: "lowercase secret", secret: strings.ToLower(rfcSecret), code: generate(key, current), wantStep: current, wantOK: true},
+		{name: "short code", secret: rfcSecret, code: "12345"},
+		{name: "invalid secret", secret: "not base32!", code: generate(key, current)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(tt.secret, tt.code, now)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != tt.wantStep {
+				t.Errorf("step = %d, want %d", step, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestIsCode(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "123456", want: true},
+		{value: "000000", want: true},
+		{value: "12345", want: false},
+		{value: "1234567", want: false},
+		{value: "12a456", want: false},
+		{value: "0a1b2-c3d4e", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := IsCode(tt.value); got != tt.want {
+				t.Errorf("IsCode(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	c, err := NewCipher(strings.Repeat("ab", 32))
+	if err != nil {
+		t.Fatalf("NewCipher: %v", err)
+	}
+
+	encrypted, err := c.Encrypt(rfcSecret)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if encrypted == rfcSecret {
+		t.Fatal("Encrypt returned plain secret")
+	}
+
+	decrypted, err := c.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if decrypted != rfcSecret {
+		t.Errorf("Decrypt = %q, want %q", decrypted, rfcSecret)
+	}
+
+	if _, err := NewCipher(strings.Repeat("ab", 16)); err == nil {
+		t.Error("NewCipher with 16 bytes key error = nil, want error")
+	}
+}
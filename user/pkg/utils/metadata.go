@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/AleksK1NG/hotels-mocroservices/user/pkg/grpc_errors"
+)
+
+const (
+	// UserIDMetadataKey gRPC metadata key with id of the user who made the request
+	UserIDMetadataKey = "user_id"
+)
+
+// GetUserIDFromCtx get request user id from incoming gRPC metadata
+func GetUserIDFromCtx(ctx context.Context) (uuid.UUID, error) {
+	value, err := getMetadataValue(ctx, UserIDMetadataKey)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.FromString(value)
+}
+
+func getMetadataValue(ctx context.Context, key string) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", grpc_errors.ErrNoCtxMetaData
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return "", grpc_errors.ErrNoCtxMetaData
+	}
+
+	return values[0], nil
+}
@@ -1,20 +1,21 @@
 package utils
 
 import (
-	"strconv"
-
 	uuid "github.com/satori/go.uuid"
 )
 
-// CreateSQLPlaceholders Generate postgres $ placeholders
-func CreateSQLPlaceholders(length int) string {
-	var placeholders string
-	for i := 0; i < length; i++ {
-		placeholders += `$` + strconv.Itoa(i+1) + `,`
-
+// UniqueStrings remove duplicates keeping first occurrence order
+func UniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		unique = append(unique, v)
 	}
-	placeholders = placeholders[:len(placeholders)-1]
-	return placeholders
+	return unique
 }
 
 // ConvertStringArrToUUID convert string slice to uuid